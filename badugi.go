@@ -0,0 +1,130 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// BadugiRank is the strength of a badugi hand: the largest set of cards of the hand with
+// distinct ranks and distinct suits, aces low. A four-card badugi beats any three-card hand,
+// and hands of the same size are compared by their highest card first, so the best hand
+// is 4-3-2-A of four suits. A greater BadugiRank is a better, i.e. lower, hand.
+type BadugiRank uint32
+
+// badugiMaxCards is the number of cards of a complete badugi.
+const badugiMaxCards = 4
+
+// NewBadugiRank returns the BadugiRank of a hand of 1 to 4 cards with the given ranks
+// from the highest, aces low, e.g. NewBadugiRank(RankEight, RankFour, RankThree, RankAce).
+func NewBadugiRank(ranks ...Rank) BadugiRank {
+	ranks = ranks[:min(len(ranks), badugiMaxCards)]
+	badness := uint32(badugiMaxCards-len(ranks)) << handRankCategoryShift
+	for i, rank := range ranks {
+		shift := handRankKickerBits * (handRankMaxKickers - 1 - i)
+		badness |= uint32(aceLowValue(rank)) << shift
+	}
+	return BadugiRank(lowRankMax - badness)
+}
+
+// Size returns the number of cards of the badugi, from 1 to 4.
+func (r BadugiRank) Size() int {
+	return len(r.Ranks())
+}
+
+// Ranks returns the ranks of the badugi from the highest, aces low, e.g. [8 4 3 A].
+func (r BadugiRank) Ranks() []Rank {
+	return LowRank(r).Ranks()
+}
+
+// Compare returns 1 if r is better than o, -1 if r is worse, and 0 if they tie.
+func (r BadugiRank) Compare(o BadugiRank) int {
+	return cmp.Compare(r, o)
+}
+
+// Less reports whether r is worse than o.
+func (r BadugiRank) Less(o BadugiRank) bool {
+	return r < o
+}
+
+func (r BadugiRank) String() string {
+	return r.Describe()
+}
+
+var badugiSizeNames = [...]string{1: "one-card", 2: "two-card", 3: "three-card"}
+
+// Describe returns the hand in words with every rank, e.g. "8-4-3-A badugi" for four cards
+// or "three-card 8-4-2" for fewer.
+func (r BadugiRank) Describe() string {
+	return r.describe(r.Ranks())
+}
+
+// DescribeShort returns the hand in words by its highest card, e.g. "8 badugi" or "three-card 8".
+func (r BadugiRank) DescribeShort() string {
+	ranks := r.Ranks()
+	return r.describe(ranks[:min(1, len(ranks))])
+}
+
+func (r BadugiRank) describe(ranks []Rank) string {
+	switch size := r.Size(); size {
+	case badugiMaxCards:
+		return joinRanks(ranks) + " badugi"
+	case 1, 2, 3:
+		return badugiSizeNames[size] + " " + joinRanks(ranks)
+	default:
+		return "unknown"
+	}
+}
+
+// EvaluateBadugi returns the best badugi of four cards and its cards,
+// ordered like the ranks of the BadugiRank.
+func EvaluateBadugi(cards []Card) (BadugiRank, []Card, error) {
+	if len(cards) != badugiMaxCards {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be %d, got %d", badugiMaxCards, len(cards))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
+	}
+
+	var best BadugiRank
+	var bestCards []Card
+	// Each bit of subset picks one of the cards.
+	for subset := 1; subset < 1<<len(cards); subset++ {
+		var picked []Card
+		var ranks, suits uint16
+		valid := true
+		for i, c := range cards {
+			if subset&(1<<i) == 0 {
+				continue
+			}
+			if ranks&(1<<c.Rank) != 0 || suits&(1<<c.Suit) != 0 {
+				valid = false
+				break
+			}
+			ranks, suits = ranks|1<<c.Rank, suits|1<<c.Suit
+			picked = append(picked, c)
+		}
+		if !valid {
+			continue
+		}
+
+		slices.SortFunc(picked, func(a, b Card) int {
+			return cmp.Compare(aceLowValue(b.Rank), aceLowValue(a.Rank))
+		})
+		r := make([]Rank, 0, len(picked))
+		for _, c := range picked {
+			r = append(r, c.Rank)
+		}
+		if rank := NewBadugiRank(r...); rank > best {
+			best, bestCards = rank, picked
+		}
+	}
+	return best, bestCards, nil
+}
+
+// BadugiEvaluator ranks badugi hands like EvaluateBadugi, as BadugiRank values.
+var BadugiEvaluator Evaluator = EvaluatorFunc(func(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateBadugi(cards)
+	return HandRank(rank), err
+})
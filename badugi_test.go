@@ -0,0 +1,102 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluateBadugi(t *testing.T) {
+	tests := []struct {
+		cards     string
+		wantCards string
+		wantLong  string
+		wantShort string
+		wantSize  int
+	}{
+		{cards: "4c 3d 2h As", wantCards: "4c 3d 2h As", wantLong: "4-3-2-A badugi", wantShort: "4 badugi", wantSize: 4},
+		{cards: "As 3h Kc 8d", wantCards: "Kc 8d 3h As", wantLong: "K-8-3-A badugi", wantShort: "K badugi", wantSize: 4},
+		{cards: "Ac Ad 2h 3s", wantCards: "3s 2h Ac", wantLong: "three-card 3-2-A", wantShort: "three-card 3", wantSize: 3},
+		{cards: "5h 4h 3d 2d", wantCards: "4h 2d", wantLong: "two-card 4-2", wantShort: "two-card 4", wantSize: 2},
+		{cards: "Kc Qc 2c Ac", wantCards: "Ac", wantLong: "one-card A", wantShort: "one-card A", wantSize: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cards, func(t *testing.T) {
+			rank, cards, err := poker.EvaluateBadugi(poker.MustParseCards(tt.cards))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantCards), cards); diff != "" {
+				t.Errorf("cards mismatch (-want +got):\n%s", diff)
+			}
+			if got := rank.Size(); got != tt.wantSize {
+				t.Errorf("Size() = %d, want %d", got, tt.wantSize)
+			}
+			if got := rank.Describe(); got != tt.wantLong {
+				t.Errorf("Describe() = %q, want %q", got, tt.wantLong)
+			}
+			if got := rank.DescribeShort(); got != tt.wantShort {
+				t.Errorf("DescribeShort() = %q, want %q", got, tt.wantShort)
+			}
+		})
+	}
+}
+
+func TestEvaluateBadugi_Invalid(t *testing.T) {
+	for _, cards := range []string{"4c 3d 2h", "4c 3d 2h As Ks", "4c 3d 2h Xs"} {
+		t.Run(cards, func(t *testing.T) {
+			if _, _, err := poker.EvaluateBadugi(poker.MustParseCards(cards)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestBadugiRank_Order(t *testing.T) {
+	// From the best to the worst.
+	ranks := []poker.BadugiRank{
+		poker.NewBadugiRank(poker.RankFour, poker.RankThree, poker.RankDeuce, poker.RankAce),
+		poker.NewBadugiRank(poker.RankFive, poker.RankThree, poker.RankDeuce, poker.RankAce),
+		poker.NewBadugiRank(poker.RankFive, poker.RankFour, poker.RankDeuce, poker.RankAce),
+		poker.NewBadugiRank(poker.RankKing, poker.RankQueen, poker.RankJack, poker.RankTen),
+		poker.NewBadugiRank(poker.RankThree, poker.RankDeuce, poker.RankAce),
+		poker.NewBadugiRank(poker.RankKing, poker.RankQueen, poker.RankJack),
+		poker.NewBadugiRank(poker.RankDeuce, poker.RankAce),
+		poker.NewBadugiRank(poker.RankAce),
+		poker.NewBadugiRank(poker.RankKing),
+	}
+	for i := 1; i < len(ranks); i++ {
+		if !ranks[i].Less(ranks[i-1]) || ranks[i-1].Compare(ranks[i]) != 1 {
+			t.Errorf("%s is not better than %s", ranks[i-1], ranks[i])
+		}
+	}
+}
+
+func TestBadugiEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "king badugi", Hand: poker.MustParseCards("Kc 8d 3h As")},
+		{Name: "wheel 1", Hand: poker.MustParseCards("4c 3d 2s Ah")},
+		{Name: "three-card", Hand: poker.MustParseCards("5c 6d 7h 7s")},
+		{Name: "wheel 2", Hand: poker.MustParseCards("4h 3s 2c Ad")},
+	}
+
+	winners, err := poker.CompareHands(players, nil, poker.WithEvaluator(poker.BadugiEvaluator))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{"wheel 1", "wheel 2"}
+	if diff := cmp.Diff(want, playerNames(winners), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("winners mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err := poker.BadugiEvaluator.Evaluate(players[2].Hand)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff("three-card 7-6-5", poker.BadugiRank(got).Describe()); diff != "" {
+		t.Errorf("Describe() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -25,6 +25,7 @@ func BenchmarkCalcEquity(b *testing.B) {
 	}
 
 	b.Run("poker.EvaluateEquity", func(b *testing.B) {
+		b.ReportAllocs()
 		for i := 0; i < b.N; i++ {
 			_, err := poker.EvaluateEquity(in)
 			if err != nil {
@@ -34,6 +35,7 @@ func BenchmarkCalcEquity(b *testing.B) {
 	})
 
 	b.Run("poker.EvaluateEquityByMadeHand", func(b *testing.B) {
+		b.ReportAllocs()
 		for i := 0; i < b.N; i++ {
 			_, err := poker.EvaluateEquityByMadeHand(in)
 			if err != nil {
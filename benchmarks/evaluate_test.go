@@ -0,0 +1,25 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/whywaita/poker-go"
+)
+
+func BenchmarkEvaluate(b *testing.B) {
+	hands := map[string][]poker.Card{
+		"rainbow": poker.MustParseCards("Ah Kd 9c 7s 4h 3d 2c"),
+		"flush":   poker.MustParseCards("Ah Kh 9h 7s 4h 3h 2c"),
+	}
+
+	for name, cards := range hands {
+		b.Run("poker.NewBestMadeHand/"+name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if poker.NewBestMadeHand(cards).Value == 0 {
+					b.Fatal("unexpected value")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var allSuits = [4]Suit{Hearts, Clubs, Diamonds, Spades}
+
+// SuitPermutation maps every suit to the suit that replaces it.
+// The index is the original suit.
+type SuitPermutation [4]Suit
+
+// IdentityPermutation leaves every suit unchanged.
+var IdentityPermutation = SuitPermutation{Hearts, Clubs, Diamonds, Spades}
+
+// Apply returns c with its suit replaced.
+func (p SuitPermutation) Apply(c Card) Card {
+	if c.Suit < Hearts || c.Suit > Spades {
+		return c
+	}
+	return Card{Rank: c.Rank, Suit: p[c.Suit]}
+}
+
+// ApplyAll returns a copy of cards with their suits replaced.
+func (p SuitPermutation) ApplyAll(cards []Card) []Card {
+	ret := make([]Card, len(cards))
+	for i, c := range cards {
+		ret[i] = p.Apply(c)
+	}
+	return ret
+}
+
+// Inverse returns the permutation that undoes p.
+func (p SuitPermutation) Inverse() SuitPermutation {
+	var inv SuitPermutation
+	for from, to := range p {
+		inv[to] = Suit(from)
+	}
+	return inv
+}
+
+// Canonicalize maps hole cards and board to the canonical representative of all
+// spots that are identical up to a suit permutation. For example, AhKh on QsJs2d
+// and AdKd on QcJc2h both become AhKh on QcJc2d.
+//
+// The hole cards and the board are each treated as unordered, and are returned sorted
+// by rank from high to low. The returned permutation maps the original suits to
+// the canonical ones, so its Inverse maps results back.
+func Canonicalize(hole, board []Card) ([]Card, []Card, SuitPermutation) {
+	bestPerm := IdentityPermutation
+	bestHole := NewCardSet(hole...)
+	bestBoard := NewCardSet(board...)
+
+	forEachSuitPermutation(func(p SuitPermutation) {
+		h := NewCardSet(p.ApplyAll(hole)...)
+		b := NewCardSet(p.ApplyAll(board)...)
+		if h < bestHole || (h == bestHole && b < bestBoard) {
+			bestPerm, bestHole, bestBoard = p, h, b
+		}
+	})
+
+	return sortCanonical(bestPerm.ApplyAll(hole)), sortCanonical(bestPerm.ApplyAll(board)), bestPerm
+}
+
+func sortCanonical(cards []Card) []Card {
+	sort.Slice(cards, func(i, j int) bool {
+		if cards[i].Rank != cards[j].Rank {
+			return cards[i].Rank > cards[j].Rank
+		}
+		return cards[i].Suit < cards[j].Suit
+	})
+	return cards
+}
+
+// forEachSuitPermutation calls fn with each of the 24 suit permutations.
+func forEachSuitPermutation(fn func(SuitPermutation)) {
+	var p SuitPermutation
+	var used [4]bool
+	var rec func(i int)
+	rec = func(i int) {
+		if i == len(p) {
+			fn(p)
+			return
+		}
+		for _, s := range allSuits {
+			if used[s] {
+				continue
+			}
+			used[s] = true
+			p[i] = s
+			rec(i + 1)
+			used[s] = false
+		}
+	}
+	rec(0)
+}
+
+// StartingHand is one of the 169 preflop starting-hand classes such as "AKs", "T9o" or "77".
+type StartingHand struct {
+	High   Rank
+	Low    Rank
+	Suited bool
+}
+
+// NewStartingHand returns the class of the given two hole cards.
+func NewStartingHand(hole []Card) (StartingHand, error) {
+	if len(hole) != 2 {
+		return StartingHand{}, fmt.Errorf("starting hand must be exactly 2 cards, got %d", len(hole))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, hole...); err != nil {
+		return StartingHand{}, err
+	}
+	high, low := hole[0], hole[1]
+	if low.Rank > high.Rank {
+		high, low = low, high
+	}
+	return StartingHand{High: high.Rank, Low: low.Rank, Suited: high.Suit == low.Suit}, nil
+}
+
+// ParseStartingHand parses a class such as "AKs", "T9o" or "77".
+func ParseStartingHand(s string) (StartingHand, error) {
+	var h StartingHand
+	if err := h.UnmarshalText([]byte(s)); err != nil {
+		return StartingHand{}, err
+	}
+	return h, nil
+}
+
+// AllStartingHands returns the 169 starting-hand classes in the order of the usual
+// 13x13 grid: rows from aces down, pairs on the diagonal, suited hands above it
+// and offsuit hands below it.
+func AllStartingHands() []StartingHand {
+	hands := make([]StartingHand, 0, 169)
+	for row := RankAce; row >= RankDeuce; row-- {
+		for col := RankAce; col >= RankDeuce; col-- {
+			switch {
+			case row == col:
+				hands = append(hands, StartingHand{High: row, Low: col})
+			case col < row:
+				hands = append(hands, StartingHand{High: row, Low: col, Suited: true})
+			default:
+				hands = append(hands, StartingHand{High: col, Low: row})
+			}
+		}
+	}
+	return hands
+}
+
+// IsPair reports whether both cards have the same rank.
+func (h StartingHand) IsPair() bool {
+	return h.High == h.Low
+}
+
+// Combos returns every pair of hole cards in the class:
+// 6 for a pair, 4 for a suited hand and 12 for an offsuit hand.
+func (h StartingHand) Combos() [][]Card {
+	var combos [][]Card
+	for i, s1 := range allSuits {
+		for j, s2 := range allSuits {
+			switch {
+			case h.IsPair() && j <= i:
+				continue
+			case !h.IsPair() && h.Suited != (s1 == s2):
+				continue
+			}
+			combos = append(combos, []Card{{Rank: h.High, Suit: s1}, {Rank: h.Low, Suit: s2}})
+		}
+	}
+	return combos
+}
+
+func (h StartingHand) String() string {
+	s := h.High.String() + h.Low.String()
+	switch {
+	case h.IsPair():
+		return s
+	case h.Suited:
+		return s + "s"
+	default:
+		return s + "o"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (h StartingHand) MarshalText() ([]byte, error) {
+	if _, err := h.High.MarshalText(); err != nil {
+		return nil, err
+	}
+	if _, err := h.Low.MarshalText(); err != nil {
+		return nil, err
+	}
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *StartingHand) UnmarshalText(text []byte) error {
+	s := string(text)
+	if len(s) < 2 || len(s) > 3 {
+		return fmt.Errorf("invalid starting hand: %q", s)
+	}
+	var r1, r2 Rank
+	if err := r1.UnmarshalText([]byte(s[:1])); err != nil {
+		return fmt.Errorf("invalid starting hand: %q", s)
+	}
+	if err := r2.UnmarshalText([]byte(s[1:2])); err != nil {
+		return fmt.Errorf("invalid starting hand: %q", s)
+	}
+	if r1 == RankJoker || r2 == RankJoker {
+		return fmt.Errorf("invalid starting hand: %q", s)
+	}
+	if r2 > r1 {
+		r1, r2 = r2, r1
+	}
+
+	suffix := strings.ToLower(s[2:])
+	switch {
+	case r1 == r2 && suffix == "":
+		*h = StartingHand{High: r1, Low: r2}
+	case r1 != r2 && suffix == "s":
+		*h = StartingHand{High: r1, Low: r2, Suited: true}
+	case r1 != r2 && suffix == "o":
+		*h = StartingHand{High: r1, Low: r2}
+	default:
+		return fmt.Errorf("invalid starting hand: %q", s)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		hole      string
+		board     string
+		wantHole  string
+		wantBoard string
+	}{
+		{name: "suited on two-tone flop", hole: "AhKh", board: "Qs Js 2d", wantHole: "Ah Kh", wantBoard: "Qc Jc 2d"},
+		{name: "same spot in other suits", hole: "AdKd", board: "Qc Jc 2h", wantHole: "Ah Kh", wantBoard: "Qc Jc 2d"},
+		{name: "order does not matter", hole: "KdAd", board: "2h Jc Qc", wantHole: "Ah Kh", wantBoard: "Qc Jc 2d"},
+		{name: "offsuit preflop", hole: "7s 2c", board: "", wantHole: "7h 2c", wantBoard: ""},
+		{name: "pair on monotone board", hole: "9c 9d", board: "Ah Kh 4h", wantHole: "9h 9c", wantBoard: "Ad Kd 4d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole := poker.MustParseCards(tt.hole)
+			board := poker.MustParseCards(tt.board)
+
+			gotHole, gotBoard, perm := poker.Canonicalize(hole, board)
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantHole), gotHole); diff != "" {
+				t.Errorf("hole mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantBoard), gotBoard, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("board mismatch (-want +got):\n%s", diff)
+			}
+
+			// The permutation maps the original cards onto the canonical ones and back.
+			if got := poker.NewCardSet(perm.ApplyAll(hole)...); got != poker.NewCardSet(gotHole...) {
+				t.Errorf("permutation maps hole to %v, want %v", got, gotHole)
+			}
+			if got := poker.NewCardSet(perm.Inverse().ApplyAll(gotBoard)...); got != poker.NewCardSet(board...) {
+				t.Errorf("inverse permutation maps board to %v, want %v", got, board)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_AllStartingHands(t *testing.T) {
+	// Canonicalizing every two-card combination must yield exactly the 169 classes.
+	classes := make(map[string]bool)
+	for _, hole := range poker.AllCombinations(poker.NewDeck().Cards, 2) {
+		h, _, _ := poker.Canonicalize(hole, nil)
+		classes[poker.Cards(h).String()] = true
+	}
+	if len(classes) != 169 {
+		t.Errorf("got %d canonical preflop hands, want 169", len(classes))
+	}
+}
+
+func TestStartingHand(t *testing.T) {
+	tests := []struct {
+		hole       string
+		want       string
+		wantCombos int
+	}{
+		{hole: "Ah Kh", want: "AKs", wantCombos: 4},
+		{hole: "9d Tc", want: "T9o", wantCombos: 12},
+		{hole: "7s 7c", want: "77", wantCombos: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := poker.NewStartingHand(poker.MustParseCards(tt.hole))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+			parsed, err := poker.ParseStartingHand(tt.want)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if parsed != got {
+				t.Errorf("ParseStartingHand(%q) = %+v, want %+v", tt.want, parsed, got)
+			}
+
+			combos := got.Combos()
+			if len(combos) != tt.wantCombos {
+				t.Errorf("Combos() has %d entries, want %d", len(combos), tt.wantCombos)
+			}
+			for _, c := range combos {
+				if h, _ := poker.NewStartingHand(c); h != got {
+					t.Errorf("combo %v belongs to %s, want %s", c, h, got)
+				}
+			}
+		})
+	}
+
+	for _, s := range []string{"", "A", "AAs", "AKx", "AK", "ZZ", "XX"} {
+		if _, err := poker.ParseStartingHand(s); err == nil {
+			t.Errorf("ParseStartingHand(%q): expected error, got nil", s)
+		}
+	}
+}
+
+func TestAllStartingHands(t *testing.T) {
+	hands := poker.AllStartingHands()
+	if len(hands) != 169 {
+		t.Fatalf("got %d starting hands, want 169", len(hands))
+	}
+	want := []string{"AA", "AKs", "AQs"}
+	for i, w := range want {
+		if hands[i].String() != w {
+			t.Errorf("hands[%d] = %s, want %s", i, hands[i], w)
+		}
+	}
+	if got := hands[13].String(); got != "AKo" {
+		t.Errorf("hands[13] = %s, want AKo", got)
+	}
+	if got := hands[168].String(); got != "22" {
+		t.Errorf("hands[168] = %s, want 22", got)
+	}
+
+	var combos int
+	for _, h := range hands {
+		combos += len(h.Combos())
+	}
+	if combos != 1326 {
+		t.Errorf("got %d combos, want 1326", combos)
+	}
+}
@@ -5,21 +5,23 @@ import (
 )
 
 // EvaluateEquity returns the equity of each player in the game.
-func EvaluateEquity(players []Player) ([]float64, error) {
-	deck := NewDeck()
-
-	for _, p := range players {
-		for _, c := range p.Hand {
-			deck.RemoveCard(c)
-		}
+func EvaluateEquity(players []Player, opts ...Option) ([]float64, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, nil); err != nil {
+		return nil, err
+	}
+	deck, err := o.remainingDeck(playersCards(players))
+	if err != nil {
+		return nil, err
 	}
 
+	compare := o.compare(compareHands)
 	wins := make([]int, len(players))
 
 	var ties int
 	var total int
 	for _, board := range AllCombinations(deck.Cards, 5) {
-		winners, err := CompareHands(players, board)
+		winners, err := compare(players, board)
 		if err != nil {
 			return nil, err
 		}
@@ -44,18 +46,16 @@ func EvaluateEquity(players []Player) ([]float64, error) {
 	return equities, nil
 }
 
-// Evaluate returns the best hand type and cards for the given cards.
-// The cards slice must contain exactly 7 unique cards (no duplicates).
+// Evaluate returns the best hand type and the best five cards for the given cards.
+// The cards slice must contain 5 to 7 unique valid cards (no duplicates),
+// e.g. hole cards plus the flop, the turn or the river.
 func Evaluate(cards []Card) (HandType, []Card, error) {
-	if len(cards) != 7 {
-		return 0, nil, fmt.Errorf("invalid number of cards")
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be 5 to 7, got %d", len(cards))
 	}
-	seen := make(map[Card]bool, 7)
-	for _, c := range cards {
-		if seen[c] {
-			return 0, nil, fmt.Errorf("duplicate card: %s", c)
-		}
-		seen[c] = true
+	var v cardValidator
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
 	}
 	return evaluate(cards)
 }
@@ -135,7 +135,15 @@ func evaluate(cards []Card) (HandType, []Card, error) {
 }
 
 // CompareHands returns the winner(s) of the game.
-func CompareHands(players []Player, board []Card) ([]Player, error) {
+func CompareHands(players []Player, board []Card, opts ...Option) ([]Player, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, board); err != nil {
+		return nil, err
+	}
+	return o.compare(compareHands)(players, board)
+}
+
+func compareHands(players []Player, board []Card) ([]Player, error) {
 	type maxPlayer struct {
 		player    Player
 		hand      []Card
@@ -259,6 +267,15 @@ func compareTieHands(hand1, hand2 []Card, board []Card, score HandType) (Winner,
 	}
 }
 
+// playersCards returns the hole cards of all players.
+func playersCards(players []Player) []Card {
+	var cards []Card
+	for _, p := range players {
+		cards = append(cards, p.Hand...)
+	}
+	return cards
+}
+
 // AllCombinations returns all combinations of k elements from the given slice.
 func AllCombinations(cards []Card, k int) [][]Card {
 	if k == 0 {
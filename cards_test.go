@@ -327,3 +327,47 @@ func TestEvaluateEquity(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluate_CardCounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantType  poker.HandType
+		wantCards string
+		wantErr   bool
+	}{
+		{name: "5 cards royal flush", input: "Ah Kh Qh Jh Th", wantType: poker.HandTypeRoyalFlush, wantCards: "Th Jh Qh Kh Ah"},
+		{name: "5 cards wheel", input: "5c 4d 3h 2s Ac", wantType: poker.HandTypeStraight, wantCards: "5c 4d 3h 2s Ac"},
+		{name: "5 cards full house", input: "4d 9s 4c 9d 9h", wantType: poker.HandTypeFullHouse, wantCards: "9s 9d 9h 4d 4c"},
+		{name: "5 cards two pair", input: "7h Ks 2d 7c Kd", wantType: poker.HandTypeTwoPair, wantCards: "Ks Kd 7h 7c 2d"},
+		{name: "5 cards high card", input: "Ah Kd Qc 9s 7d", wantType: poker.HandTypeHighCard, wantCards: "Ah Kd Qc 9s 7d"},
+		{name: "6 cards flush", input: "Kh 9h 6h 3h 2h 2c", wantType: poker.HandTypeFlush, wantCards: "Kh 9h 6h 3h 2h"},
+		{name: "6 cards straight flush", input: "Ts 9s 8s 7s 6s 5s", wantType: poker.HandTypeStraightFlush, wantCards: "6s 7s 8s 9s Ts"},
+		{name: "6 cards three pairs", input: "2c 2d 3c 3d Ac Ad", wantType: poker.HandTypeTwoPair, wantCards: "Ac Ad 3c 3d 2c"},
+		{name: "6 cards four of a kind", input: "7h 7d 7c 7s Kd Qd", wantType: poker.HandTypeFourOfAKind, wantCards: "7h 7d 7c 7s Kd"},
+		{name: "7 cards two pair", input: "Ks Kd 7h 7c 2d 3s 4c", wantType: poker.HandTypeTwoPair, wantCards: "Ks Kd 7h 7c 4c"},
+		{name: "4 cards", input: "Ah Kh Qh Jh", wantErr: true},
+		{name: "8 cards", input: "Ah Kh Qh Jh Th 9h 8h 7h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotCards, err := poker.Evaluate(poker.MustParseCards(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if gotType != tt.wantType {
+				t.Errorf("hand type = %s, want %s", gotType, tt.wantType)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantCards), gotCards); diff != "" {
+				t.Errorf("cards mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
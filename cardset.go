@@ -0,0 +1,121 @@
+package poker
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// CardSet is a set of cards backed by a bitmask.
+// Each suit occupies 16 bits and each rank one bit within it,
+// so the zero value is an empty set and set operations are single instructions.
+// Jokers use the bit just above the ace of their suit.
+type CardSet uint64
+
+const (
+	cardSetSuitWidth = 16
+	cardSetRankMask  = 0x1fff
+)
+
+// FullCardSet contains all 52 cards of a standard deck.
+const FullCardSet CardSet = cardSetRankMask |
+	cardSetRankMask<<cardSetSuitWidth |
+	cardSetRankMask<<(2*cardSetSuitWidth) |
+	cardSetRankMask<<(3*cardSetSuitWidth)
+
+// NewCardSet returns a set containing the given cards.
+func NewCardSet(cards ...Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s |= cardBit(c)
+	}
+	return s
+}
+
+// cardBit returns the bit for c, or 0 if c is not a valid card.
+func cardBit(c Card) CardSet {
+	if !isValidCard(c, true) {
+		return 0
+	}
+	return 1 << (uint(c.Suit)*cardSetSuitWidth + uint(c.Rank-RankDeuce))
+}
+
+// Add returns a set with c added.
+func (s CardSet) Add(c Card) CardSet {
+	return s | cardBit(c)
+}
+
+// Remove returns a set with c removed.
+func (s CardSet) Remove(c Card) CardSet {
+	return s &^ cardBit(c)
+}
+
+// Contains reports whether c is in the set.
+func (s CardSet) Contains(c Card) bool {
+	b := cardBit(c)
+	return b != 0 && s&b != 0
+}
+
+// ContainsAll reports whether every card of o is in the set.
+func (s CardSet) ContainsAll(o CardSet) bool {
+	return s&o == o
+}
+
+// Union returns the cards in either set.
+func (s CardSet) Union(o CardSet) CardSet {
+	return s | o
+}
+
+// Intersect returns the cards in both sets.
+func (s CardSet) Intersect(o CardSet) CardSet {
+	return s & o
+}
+
+// Difference returns the cards in s that are not in o.
+func (s CardSet) Difference(o CardSet) CardSet {
+	return s &^ o
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// IsEmpty reports whether the set has no cards.
+func (s CardSet) IsEmpty() bool {
+	return s == 0
+}
+
+// RankMask returns the ranks held in the given suit as a 13-bit mask,
+// where bit 0 is the deuce and bit 12 is the ace.
+func (s CardSet) RankMask(suit Suit) uint16 {
+	return uint16(uint64(s)>>(uint(suit)*cardSetSuitWidth)) & cardSetRankMask
+}
+
+// All iterates over the cards in the set in the same order as NewDeck.
+func (s CardSet) All() iter.Seq[Card] {
+	return func(yield func(Card) bool) {
+		for rest := uint64(s); rest != 0; rest &= rest - 1 {
+			i := bits.TrailingZeros64(rest)
+			c := Card{
+				Rank: RankDeuce + Rank(i%cardSetSuitWidth),
+				Suit: Suit(i / cardSetSuitWidth),
+			}
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// Cards returns the cards in the set in the same order as NewDeck.
+func (s CardSet) Cards() []Card {
+	cards := make([]Card, 0, s.Count())
+	for c := range s.All() {
+		cards = append(cards, c)
+	}
+	return cards
+}
+
+func (s CardSet) String() string {
+	return Cards(s.Cards()).String()
+}
@@ -0,0 +1,100 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestCardSet(t *testing.T) {
+	a := poker.NewCardSet(poker.MustParseCards("Ah Kd Qs")...)
+	b := poker.NewCardSet(poker.MustParseCards("Kd 2c")...)
+
+	tests := []struct {
+		name string
+		got  poker.CardSet
+		want []poker.Card
+	}{
+		{name: "union", got: a.Union(b), want: poker.MustParseCards("Ah 2c Kd Qs")},
+		{name: "intersect", got: a.Intersect(b), want: poker.MustParseCards("Kd")},
+		{name: "difference", got: a.Difference(b), want: poker.MustParseCards("Ah Qs")},
+		{name: "add", got: b.Add(poker.Card{Rank: poker.RankAce, Suit: poker.Spades}), want: poker.MustParseCards("2c Kd As")},
+		{name: "remove", got: a.Remove(poker.Card{Rank: poker.RankKing, Suit: poker.Diamonds}), want: poker.MustParseCards("Ah Qs")},
+		{name: "invalid card is ignored", got: b.Add(poker.Card{Rank: poker.RankUnknown, Suit: poker.Hearts}), want: poker.MustParseCards("2c Kd")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.got.Cards()); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if tt.got.Count() != len(tt.want) {
+				t.Errorf("Count() = %d, want %d", tt.got.Count(), len(tt.want))
+			}
+		})
+	}
+
+	if !a.Contains(poker.Card{Rank: poker.RankQueen, Suit: poker.Spades}) {
+		t.Error("expected set to contain Qs")
+	}
+	if a.Contains(poker.Card{Rank: poker.RankDeuce, Suit: poker.Clubs}) {
+		t.Error("expected set not to contain 2c")
+	}
+	if !a.Union(b).ContainsAll(b) || a.ContainsAll(b) {
+		t.Error("unexpected ContainsAll result")
+	}
+	if got := a.String(); got != "[Ah Kd Qs]" {
+		t.Errorf("String() = %s, want [Ah Kd Qs]", got)
+	}
+	if got := a.RankMask(poker.Hearts); got != 1<<12 {
+		t.Errorf("RankMask(Hearts) = %b, want %b", got, 1<<12)
+	}
+}
+
+func TestCardSet_FullDeck(t *testing.T) {
+	deck := poker.NewDeck()
+	if diff := cmp.Diff(deck.Cards, poker.FullCardSet.Cards()); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if got := deck.CardSet(); got != poker.FullCardSet {
+		t.Errorf("CardSet() = %v, want full deck", got)
+	}
+	if got := poker.NewDeckFromCardSet(poker.FullCardSet); len(got.Cards) != 52 {
+		t.Errorf("NewDeckFromCardSet() has %d cards, want 52", len(got.Cards))
+	}
+}
+
+func TestDeck_RemoveCardSet(t *testing.T) {
+	deck := poker.NewDeck()
+	removed := deck.RemoveCardSet(poker.NewCardSet(poker.MustParseCards("Ah Kd Qs")...))
+	if removed != 3 {
+		t.Errorf("removed = %d, want 3", removed)
+	}
+	if len(deck.Cards) != 49 {
+		t.Errorf("deck has %d cards, want 49", len(deck.Cards))
+	}
+	if deck.CardSet().Contains(poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}) {
+		t.Error("expected Ah to be removed")
+	}
+}
+
+func TestEvaluateEquityByMadeHandWithCommunity_DeadCards(t *testing.T) {
+	players := []poker.Player{
+		{Name: "player1", Hand: poker.MustParseCards("2h 3d")},
+		{Name: "player2", Hand: poker.MustParseCards("Ah Ad")},
+	}
+	community := poker.MustParseCards("4s 5s 8s 6c")
+
+	// Without dead cards a seven on the river splits the pot with a board straight.
+	// With all sevens dead player1's straight always wins.
+	dead := poker.NewCardSet(poker.MustParseCards("7h 7c 7d 7s")...)
+	got, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community, poker.WithDeadCards(dead))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []float64{1, 0}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -113,12 +113,13 @@ func GetPairs(cards []Card) [][]Card {
 		}
 	}
 
+	// Larger groups first, then higher ranks first.
 	sort.Slice(pairs, func(i, j int) bool {
+		if len(pairs[i]) != len(pairs[j]) {
+			return len(pairs[i]) > len(pairs[j])
+		}
 		return pairs[i][0].Rank > pairs[j][0].Rank
 	})
-	sort.Slice(pairs, func(i, j int) bool {
-		return len(pairs[i]) > len(pairs[j])
-	})
 
 	return pairs
 }
@@ -139,9 +140,6 @@ func isFullHouse(pairs [][]Card) []Card {
 		return len(pairs[i]) > len(pairs[j])
 	})
 
-	if len(pairs[0]) == 2 {
-		pairs[0], pairs[1] = pairs[1], pairs[0]
-	}
 	if len(pairs[0]) != 3 || len(pairs[1]) <= 1 {
 		return nil
 	}
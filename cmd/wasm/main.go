@@ -4,8 +4,9 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"math/rand"
+	"slices"
+	"strings"
 	"syscall/js"
 
 	"github.com/whywaita/poker-go"
@@ -13,7 +14,8 @@ import (
 
 func GenerateHands(this js.Value, args []js.Value) any {
 	cards := generateCards()
-	hand, handCards, err := poker.Evaluate(cards)
+	// Evaluate reorders the cards it is given, so keep cards intact for notUsedCards.
+	hand, handCards, err := poker.Evaluate(slices.Clone(cards))
 	if err != nil {
 		return map[string]any{
 			"ok":      false,
@@ -21,9 +23,10 @@ func GenerateHands(this js.Value, args []js.Value) any {
 		}
 	}
 
+	used := poker.NewCardSet(handCards...)
 	notUsedCards := make([]poker.Card, 0)
 	for _, card := range cards {
-		if !isExist(handCards, card) {
+		if !used.Contains(card) {
 			notUsedCards = append(notUsedCards, card)
 		}
 	}
@@ -63,16 +66,12 @@ func convertJS(cards []poker.Card) []jsCard {
 	for _, card := range cards {
 		jc := jsCard{}
 		switch card.Rank {
-		case 14:
-			jc.Rank = "ace"
-		case 13:
-			jc.Rank = "king"
-		case 12:
-			jc.Rank = "queen"
-		case 11:
-			jc.Rank = "jack"
+		case poker.RankAce, poker.RankKing, poker.RankQueen, poker.RankJack:
+			jc.Rank = strings.ToLower(card.Rank.Name())
+		case poker.RankTen:
+			jc.Rank = "10"
 		default:
-			jc.Rank = fmt.Sprintf("%d", card.Rank)
+			jc.Rank = card.Rank.String()
 		}
 		jc.Suit = card.Suit.String()
 		jsCards = append(jsCards, jc)
@@ -81,12 +80,14 @@ func convertJS(cards []poker.Card) []jsCard {
 }
 
 func generateCards() []poker.Card {
+	var seen poker.CardSet
 	cards := make([]poker.Card, 0)
 	for i := 0; i < 30; i++ {
 		rank := rand.Intn(13) + 2
 		suit := rand.Intn(4)
 		card := poker.Card{Rank: poker.UnmarshalRankInt(rank), Suit: poker.Suit(suit)}
-		if !isExist(cards, card) {
+		if !seen.Contains(card) {
+			seen = seen.Add(card)
 			cards = append(cards, card)
 			if len(cards) == 7 {
 				break
@@ -96,15 +97,6 @@ func generateCards() []poker.Card {
 	return cards
 }
 
-func isExist(cards []poker.Card, card poker.Card) bool {
-	for _, c := range cards {
-		if c.Rank == card.Rank && c.Suit == card.Suit {
-			return true
-		}
-	}
-	return false
-}
-
 func main() {
 	c := make(chan struct{}, 0)
 
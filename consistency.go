@@ -0,0 +1,417 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// DiscrepancyKind is the way two evaluators disagree about a hand.
+type DiscrepancyKind int
+
+const (
+	DiscrepancyUnknown DiscrepancyKind = iota
+	// DiscrepancyCategory means the evaluators put the hand in different categories.
+	DiscrepancyCategory
+	// DiscrepancyOrder means the evaluators disagree on which of two hands is stronger
+	// or on whether they tie.
+	DiscrepancyOrder
+	// DiscrepancyError means an evaluator returned an error.
+	DiscrepancyError
+)
+
+func (k DiscrepancyKind) String() string {
+	switch k {
+	case DiscrepancyCategory:
+		return "category"
+	case DiscrepancyOrder:
+		return "order"
+	case DiscrepancyError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Discrepancy is a disagreement between the two evaluators given to CheckEvaluators.
+type Discrepancy struct {
+	Kind DiscrepancyKind
+	// Index is the position of Hand in the enumeration or the sample,
+	// or -1 if Hand and Other are both reference hands.
+	Index int
+	Hand  []Card
+	// RankA and RankB are the ranks of Hand by the first and the second evaluator.
+	RankA HandRank
+	RankB HandRank
+	// Other is the hand that Hand was compared with for DiscrepancyOrder.
+	Other      []Card
+	OtherRankA HandRank
+	OtherRankB HandRank
+	// Err is the error returned by an evaluator for DiscrepancyError.
+	Err error
+}
+
+func (d Discrepancy) String() string {
+	switch d.Kind {
+	case DiscrepancyOrder:
+		return fmt.Sprintf("order of %s vs %s: %s vs %s, but %s vs %s",
+			Cards(d.Hand), Cards(d.Other), d.RankA, d.OtherRankA, d.RankB, d.OtherRankB)
+	case DiscrepancyError:
+		return fmt.Sprintf("error on %s: %s", Cards(d.Hand), d.Err)
+	default:
+		return fmt.Sprintf("%s of %s: %s vs %s", d.Kind, Cards(d.Hand), d.RankA, d.RankB)
+	}
+}
+
+// CheckReport is the result of CheckEvaluators.
+type CheckReport struct {
+	// Checked is the number of hands evaluated by both evaluators.
+	Checked int
+	// Discrepancies are sorted by Index.
+	Discrepancies []Discrepancy
+}
+
+// CheckOption configures CheckEvaluators.
+type CheckOption func(*checkConfig)
+
+type checkConfig struct {
+	deck             *Deck
+	fixed            []Card
+	handSize         int
+	samples          int
+	rand             *rand.Rand
+	workers          int
+	progress         func(checked, total int)
+	maxDiscrepancies int
+}
+
+// WithCheckDeck draws the hands from deck instead of a standard 52-card deck,
+// e.g. a short deck or a deck with jokers. The deck must not hold a card twice
+// and is not modified.
+func WithCheckDeck(deck *Deck) CheckOption {
+	return func(c *checkConfig) {
+		c.deck = deck
+	}
+}
+
+// WithFixedCards puts cards in every hand, e.g. the hole cards of a player,
+// so that only the rest of the hand is enumerated or sampled.
+func WithFixedCards(cards ...Card) CheckOption {
+	return func(c *checkConfig) {
+		c.fixed = cards
+	}
+}
+
+// WithHandSize sets the number of cards in each hand. The default is 7.
+func WithHandSize(n int) CheckOption {
+	return func(c *checkConfig) {
+		c.handSize = n
+	}
+}
+
+// WithSamples checks n random hands drawn with src instead of every hand.
+// A nil src uses the global random source.
+func WithSamples(n int, src rand.Source) CheckOption {
+	return func(c *checkConfig) {
+		c.samples = n
+		if src != nil {
+			c.rand = rand.New(src)
+		}
+	}
+}
+
+// WithWorkers evaluates hands in n goroutines. The default is runtime.GOMAXPROCS(0).
+// Both evaluators must be safe for concurrent use when n is greater than 1.
+func WithWorkers(n int) CheckOption {
+	return func(c *checkConfig) {
+		c.workers = max(1, n)
+	}
+}
+
+// WithProgress calls fn from a single goroutine as batches of hands are checked.
+func WithProgress(fn func(checked, total int)) CheckOption {
+	return func(c *checkConfig) {
+		c.progress = fn
+	}
+}
+
+// WithMaxDiscrepancies stops the check once n discrepancies are found.
+// With several workers, which discrepancies are returned is not specified.
+func WithMaxDiscrepancies(n int) CheckOption {
+	return func(c *checkConfig) {
+		c.maxDiscrepancies = n
+	}
+}
+
+const (
+	checkBatchSize      = 4096
+	checkReferenceHands = 256
+)
+
+// CheckEvaluators evaluates hands from a standard deck, or the deck given by WithCheckDeck,
+// with a and b and reports where they disagree. Every hand is checked for its category and
+// for an error returned by only one of the evaluators; hands that both evaluators reject are
+// skipped. The order is checked for every pair of
+// hands in a batch of 4096 hands, and for every pair of a hand and one of 256 reference hands
+// sampled once from the same deck, so that hands far apart in the enumeration are compared too.
+// By default every hand of 7 cards is checked; see WithFixedCards and WithSamples to check fewer.
+func CheckEvaluators(a, b Evaluator, opts ...CheckOption) (*CheckReport, error) {
+	cfg := checkConfig{handSize: 7, workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	deck := NewDeck()
+	if cfg.deck != nil {
+		deck = cfg.deck.Clone()
+	}
+	var seen CardSet
+	for _, c := range deck.Cards {
+		if cardBit(c) == 0 || seen.Contains(c) {
+			return nil, fmt.Errorf("invalid or duplicate card in deck: %s", c.StringShort())
+		}
+		seen = seen.Add(c)
+	}
+	v := cardValidator{jokers: true}
+	if err := v.add(OwnerHand, cfg.fixed...); err != nil {
+		return nil, err
+	}
+	for _, c := range cfg.fixed {
+		if !deck.RemoveCard(c) {
+			return nil, &CardNotInDeckError{Card: c}
+		}
+	}
+	k := cfg.handSize - len(cfg.fixed)
+	if k < 0 || k > len(deck.Cards) {
+		return nil, fmt.Errorf("invalid hand size %d with %d fixed cards", cfg.handSize, len(cfg.fixed))
+	}
+
+	total := cfg.samples
+	if total == 0 {
+		total = binomial(len(deck.Cards), k)
+	}
+	refs := referenceHands(a, b, &cfg, deck.Cards, k)
+	// Pairs of reference hands are checked here once rather than in every batch.
+	refDiscrepancies := orderDiscrepancies(slices.Clone(refs), false)
+
+	batches := make(chan checkBatch)
+	results := make(chan checkResult)
+	done := make(chan struct{})
+	go func() {
+		defer close(batches)
+		produceCheckBatches(&cfg, deck.Cards, k, total, batches, done)
+	}()
+
+	var wg sync.WaitGroup
+	for range cfg.workers {
+		wg.Go(func() {
+			for batch := range batches {
+				results <- batch.check(a, b, cfg.handSize, refs)
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	report := &CheckReport{Discrepancies: refDiscrepancies}
+	stopped := false
+	for r := range results {
+		report.Checked += r.checked
+		report.Discrepancies = append(report.Discrepancies, r.discrepancies...)
+		if cfg.progress != nil {
+			cfg.progress(report.Checked, total)
+		}
+		if !stopped && cfg.maxDiscrepancies > 0 && len(report.Discrepancies) >= cfg.maxDiscrepancies {
+			close(done)
+			stopped = true
+		}
+	}
+
+	slices.SortStableFunc(report.Discrepancies, func(x, y Discrepancy) int {
+		return cmp.Compare(x.Index, y.Index)
+	})
+	if cfg.maxDiscrepancies > 0 && len(report.Discrepancies) > cfg.maxDiscrepancies {
+		report.Discrepancies = report.Discrepancies[:cfg.maxDiscrepancies]
+	}
+	return report, nil
+}
+
+// checkBatch is a run of consecutive hands stored back to back in cards.
+type checkBatch struct {
+	first int
+	cards []Card
+}
+
+type checkResult struct {
+	checked       int
+	discrepancies []Discrepancy
+}
+
+// produceCheckBatches sends total hands to batches, each made of the fixed cards and k cards of rest.
+func produceCheckBatches(cfg *checkConfig, rest []Card, k, total int, batches chan<- checkBatch, done <-chan struct{}) {
+	hand := make([]Card, cfg.handSize)
+	copy(hand, cfg.fixed)
+	next := combinationWalker(rest, k)
+	if cfg.samples > 0 {
+		next = sampleWalker(cfg.rand, rest, k)
+	}
+
+	for first := 0; first < total; first += checkBatchSize {
+		n := min(checkBatchSize, total-first)
+		cards := make([]Card, 0, n*cfg.handSize)
+		for range n {
+			next(hand[len(cfg.fixed):])
+			cards = append(cards, hand...)
+		}
+
+		select {
+		case batches <- checkBatch{first: first, cards: cards}:
+		case <-done:
+			return
+		}
+	}
+}
+
+// combinationWalker returns a function that writes the combinations of k cards
+// of cards into dst in lexicographic order, one per call.
+func combinationWalker(cards []Card, k int) func(dst []Card) {
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	started := false
+	return func(dst []Card) {
+		if started {
+			i := k - 1
+			for i >= 0 && idx[i] == len(cards)-k+i {
+				i--
+			}
+			if i >= 0 {
+				idx[i]++
+				for j := i + 1; j < k; j++ {
+					idx[j] = idx[j-1] + 1
+				}
+			}
+		}
+		started = true
+		for i, j := range idx {
+			dst[i] = cards[j]
+		}
+	}
+}
+
+// sampleWalker returns a function that writes k random distinct cards of cards into dst.
+// A nil r uses the global random source.
+func sampleWalker(r *rand.Rand, cards []Card, k int) func(dst []Card) {
+	pool := slices.Clone(cards)
+	intN := rand.IntN
+	if r != nil {
+		intN = r.IntN
+	}
+	return func(dst []Card) {
+		for i := range k {
+			j := i + intN(len(pool)-i)
+			pool[i], pool[j] = pool[j], pool[i]
+			dst[i] = pool[i]
+		}
+	}
+}
+
+// rankedHand is a hand with its ranks by both evaluators.
+// index is its position in the enumeration, or -1 for a reference hand.
+type rankedHand struct {
+	index int
+	hand  []Card
+	rankA HandRank
+	rankB HandRank
+}
+
+// referenceHands samples hands from the fixed cards and k cards of rest with a fixed seed,
+// so that every batch is compared with the same hands. Hands that either evaluator
+// rejects are left out; the batches report them if only one does.
+func referenceHands(ea, eb Evaluator, cfg *checkConfig, rest []Card, k int) []rankedHand {
+	next := sampleWalker(rand.New(rand.NewPCG(1, 2)), rest, k)
+	refs := make([]rankedHand, 0, checkReferenceHands)
+	for range checkReferenceHands {
+		hand := make([]Card, cfg.handSize)
+		copy(hand, cfg.fixed)
+		next(hand[len(cfg.fixed):])
+		rankA, errA := ea.Evaluate(hand)
+		rankB, errB := eb.Evaluate(hand)
+		if errA == nil && errB == nil {
+			refs = append(refs, rankedHand{index: -1, hand: hand, rankA: rankA, rankB: rankB})
+		}
+	}
+	return refs
+}
+
+func (b checkBatch) check(ea, eb Evaluator, size int, refs []rankedHand) checkResult {
+	var res checkResult
+	ranked := make([]rankedHand, 0, len(b.cards)/size+len(refs))
+	for i := 0; i < len(b.cards); i += size {
+		hand := b.cards[i : i+size : i+size]
+		d := Discrepancy{Index: b.first + i/size, Hand: hand}
+		rankA, errA := ea.Evaluate(hand)
+		rankB, errB := eb.Evaluate(hand)
+		d.RankA, d.RankB = rankA, rankB
+		res.checked++
+
+		switch {
+		case errA != nil && errB != nil:
+			continue
+		case errA != nil || errB != nil:
+			d.Kind, d.Err = DiscrepancyError, cmp.Or(errA, errB)
+			res.discrepancies = append(res.discrepancies, d)
+			continue
+		case rankA.Category() != rankB.Category():
+			d.Kind = DiscrepancyCategory
+			res.discrepancies = append(res.discrepancies, d)
+		}
+		ranked = append(ranked, rankedHand{index: d.Index, hand: hand, rankA: rankA, rankB: rankB})
+	}
+	ranked = append(ranked, refs...)
+	res.discrepancies = append(res.discrepancies, orderDiscrepancies(ranked, true)...)
+	return res
+}
+
+// orderDiscrepancies sorts ranked and returns a DiscrepancyOrder for every two neighbours
+// that the evaluators order differently. Once sorted by the first evaluator and then the
+// second, both agree on every pair if and only if they agree on every two neighbours.
+// If skipRefs is true, two reference hands next to each other are not reported.
+func orderDiscrepancies(ranked []rankedHand, skipRefs bool) []Discrepancy {
+	slices.SortFunc(ranked, func(x, y rankedHand) int {
+		return cmp.Or(cmp.Compare(x.rankA, y.rankA), cmp.Compare(x.rankB, y.rankB), cmp.Compare(x.index, y.index))
+	})
+	var discrepancies []Discrepancy
+	for i := 1; i < len(ranked); i++ {
+		x, y := ranked[i-1], ranked[i]
+		if x.rankA.Compare(y.rankA) == x.rankB.Compare(y.rankB) {
+			continue
+		}
+		if y.index < 0 {
+			x, y = y, x
+		}
+		if skipRefs && y.index < 0 {
+			continue
+		}
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind: DiscrepancyOrder, Index: y.index, Hand: y.hand, RankA: y.rankA, RankB: y.rankB,
+			Other: x.hand, OtherRankA: x.rankA, OtherRankB: x.rankB,
+		})
+	}
+	return discrepancies
+}
+
+// binomial returns the number of ways to choose k items out of n.
+func binomial(n, k int) int {
+	r := 1
+	for i := range k {
+		r = r * (n - i) / (i + 1)
+	}
+	return r
+}
@@ -0,0 +1,219 @@
+package poker_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+// categoryOnly ignores kickers, so hands of the same category always tie.
+var categoryOnly = poker.EvaluatorFunc(func(cards []poker.Card) (poker.HandRank, error) {
+	rank, err := poker.RuleEvaluator.Evaluate(cards)
+	return poker.NewHandRank(rank.Category()), err
+})
+
+// noStraights reports straights as high cards.
+var noStraights = poker.EvaluatorFunc(func(cards []poker.Card) (poker.HandRank, error) {
+	rank, err := poker.RuleEvaluator.Evaluate(cards)
+	if rank.Category() == poker.HandTypeStraight {
+		return poker.NewHandRank(poker.HandTypeHighCard, rank.Kickers()...), err
+	}
+	return rank, err
+})
+
+func TestCheckEvaluators_Agree(t *testing.T) {
+	var calls, last int
+	report, err := poker.CheckEvaluators(poker.RuleEvaluator, poker.LookupEvaluator,
+		poker.WithFixedCards(poker.MustParseCards("Ah Kh")...),
+		poker.WithSamples(10000, rand.NewPCG(1, 2)),
+		poker.WithProgress(func(checked, total int) {
+			calls++
+			if checked <= last || total != 10000 {
+				t.Errorf("progress(%d, %d) after %d", checked, total, last)
+			}
+			last = checked
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if report.Checked != 10000 || last != 10000 || calls == 0 {
+		t.Errorf("checked %d hands, last progress %d after %d calls", report.Checked, last, calls)
+	}
+	if len(report.Discrepancies) != 0 {
+		t.Errorf("unexpected discrepancies: %v", report.Discrepancies)
+	}
+}
+
+func TestCheckEvaluators_Exhaustive(t *testing.T) {
+	report, err := poker.CheckEvaluators(poker.RuleEvaluator, noStraights,
+		poker.WithFixedCards(poker.MustParseCards("9h 8d 7c 6s")...),
+		poker.WithHandSize(5),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if report.Checked != 48 {
+		t.Errorf("checked %d hands, want 48", report.Checked)
+	}
+
+	var hands []poker.Cards
+	for _, d := range report.Discrepancies {
+		if d.Kind == poker.DiscrepancyCategory {
+			hands = append(hands, d.Hand)
+		}
+	}
+	want := []poker.Cards{
+		poker.MustParseCards("9h 8d 7c 6s Th"),
+		poker.MustParseCards("9h 8d 7c 6s Tc"),
+		poker.MustParseCards("9h 8d 7c 6s Td"),
+		poker.MustParseCards("9h 8d 7c 6s Ts"),
+		poker.MustParseCards("9h 8d 7c 6s 5h"),
+		poker.MustParseCards("9h 8d 7c 6s 5c"),
+		poker.MustParseCards("9h 8d 7c 6s 5d"),
+		poker.MustParseCards("9h 8d 7c 6s 5s"),
+	}
+	less := func(a, b poker.Cards) bool { return a.String() < b.String() }
+	if diff := cmp.Diff(want, hands, cmpopts.SortSlices(less)); diff != "" {
+		t.Errorf("category discrepancies mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCheckEvaluators_Order(t *testing.T) {
+	opts := []poker.CheckOption{
+		poker.WithFixedCards(poker.MustParseCards("Ah Kd 7c")...),
+		poker.WithHandSize(6),
+	}
+	report, err := poker.CheckEvaluators(poker.RuleEvaluator, categoryOnly, opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(report.Discrepancies) == 0 {
+		t.Fatal("expected discrepancies")
+	}
+	references := 0
+	for _, d := range report.Discrepancies {
+		if d.Kind != poker.DiscrepancyOrder {
+			t.Fatalf("unexpected discrepancy: %s", d)
+		}
+		if d.RankA.Compare(d.OtherRankA) == 0 || d.RankB.Compare(d.OtherRankB) != 0 {
+			t.Errorf("expected only the first evaluator to break the tie: %s", d)
+		}
+		if d.Index == -1 {
+			references++
+		}
+	}
+	if references == 0 {
+		t.Error("expected discrepancies between reference hands")
+	}
+
+	single, err := poker.CheckEvaluators(poker.RuleEvaluator, categoryOnly, append(opts, poker.WithWorkers(1))...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(single, report); diff != "" {
+		t.Errorf("results depend on the number of workers (-1 worker +default):\n%s", diff)
+	}
+
+	limited, err := poker.CheckEvaluators(poker.RuleEvaluator, categoryOnly, append(opts, poker.WithMaxDiscrepancies(3))...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(limited.Discrepancies) != 3 {
+		t.Errorf("got %d discrepancies, want 3", len(limited.Discrepancies))
+	}
+}
+
+func TestCheckEvaluators_OrderFarApart(t *testing.T) {
+	// tenHighNines ties nine-high straights with ten-high straights. Those hands are
+	// never next to each other in the enumeration, e.g. 5h and Th have 6h to 8h between them.
+	tenHighNines := poker.EvaluatorFunc(func(cards []poker.Card) (poker.HandRank, error) {
+		rank, err := poker.RuleEvaluator.Evaluate(cards)
+		if rank == poker.NewHandRank(poker.HandTypeStraight, poker.RankNine) {
+			return poker.NewHandRank(poker.HandTypeStraight, poker.RankTen), err
+		}
+		return rank, err
+	})
+
+	report, err := poker.CheckEvaluators(poker.RuleEvaluator, tenHighNines,
+		poker.WithFixedCards(poker.MustParseCards("9h 8d 7c 6s")...),
+		poker.WithHandSize(5),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(report.Discrepancies) == 0 {
+		t.Fatal("expected discrepancies")
+	}
+	for _, d := range report.Discrepancies {
+		if d.Kind != poker.DiscrepancyOrder {
+			t.Fatalf("unexpected discrepancy: %s", d)
+		}
+		if ranks := []poker.Rank{d.Hand[4].Rank, d.Other[4].Rank}; !cmp.Equal(ranks, []poker.Rank{poker.RankTen, poker.RankFive}) && !cmp.Equal(ranks, []poker.Rank{poker.RankFive, poker.RankTen}) {
+			t.Errorf("expected a nine-high and a ten-high straight: %s", d)
+		}
+	}
+}
+
+func TestCheckEvaluators_WithCheckDeck(t *testing.T) {
+	e := poker.ShortDeckEvaluator{}
+
+	// Both evaluators reject the deuces to fives of a standard deck, which is not a discrepancy.
+	report, err := poker.CheckEvaluators(e, e, poker.WithSamples(1000, rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(report.Discrepancies) != 0 {
+		t.Errorf("unexpected discrepancies: %v", report.Discrepancies[0])
+	}
+
+	report, err = poker.CheckEvaluators(e, e,
+		poker.WithCheckDeck(poker.NewDeck(poker.WithShortDeck())),
+		poker.WithFixedCards(poker.MustParseCards("9h 9d")...),
+		poker.WithHandSize(5),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if report.Checked != 5984 || len(report.Discrepancies) != 0 {
+		t.Errorf("checked %d hands with %d discrepancies, want 5984 with none", report.Checked, len(report.Discrepancies))
+	}
+
+	wild := poker.WildEvaluator{}
+	report, err = poker.CheckEvaluators(wild, wild,
+		poker.WithCheckDeck(poker.NewDeck(poker.WithJokers(2))),
+		poker.WithFixedCards(poker.RedJoker),
+		poker.WithSamples(1000, rand.NewPCG(1, 2)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(report.Discrepancies) != 0 {
+		t.Errorf("unexpected discrepancies: %v", report.Discrepancies[0])
+	}
+
+	if _, err := poker.CheckEvaluators(e, e, poker.WithCheckDeck(poker.NewDeck(poker.WithShortDeck())), poker.WithFixedCards(poker.MustParseCards("2h")...)); err == nil {
+		t.Error("expected error for a fixed card that is not in the deck")
+	}
+}
+
+func TestCheckEvaluators_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []poker.CheckOption
+	}{
+		{name: "duplicate fixed cards", opts: []poker.CheckOption{poker.WithFixedCards(poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}, poker.Card{Rank: poker.RankAce, Suit: poker.Hearts})}},
+		{name: "hand smaller than fixed cards", opts: []poker.CheckOption{poker.WithFixedCards(poker.MustParseCards("Ah Kh Qh")...), poker.WithHandSize(2)}},
+		{name: "hand larger than the deck", opts: []poker.CheckOption{poker.WithHandSize(53)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := poker.CheckEvaluators(poker.RuleEvaluator, poker.LookupEvaluator, tt.opts...); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -1,8 +1,7 @@
 package poker
 
 import (
-	"math/rand"
-	"time"
+	"math/rand/v2"
 )
 
 type Card struct {
@@ -20,6 +19,9 @@ func (c Card) StringShort() string {
 
 type Deck struct {
 	Cards []Card
+
+	src  rand.Source
+	rand *rand.Rand
 }
 
 type Suit int
@@ -93,6 +95,7 @@ const (
 	RankQueen
 	RankKing
 	RankAce
+	RankJoker
 )
 
 func (r Rank) String() string {
@@ -123,6 +126,8 @@ func (r Rank) String() string {
 		return "K"
 	case RankAce:
 		return "A"
+	case RankJoker:
+		return "X"
 	default:
 		return "Unknown"
 	}
@@ -167,6 +172,8 @@ func UnmarshalRankString(r string) Rank {
 		return RankKing
 	case "A":
 		return RankAce
+	case "X":
+		return RankJoker
 	default:
 		return RankUnknown
 	}
@@ -208,6 +215,8 @@ func UnmarshalRankInt(r int) Rank {
 type HandType int
 
 const (
+	// HandTypeFiveOfAKind can only be made with wild cards. It beats a royal flush.
+	HandTypeFiveOfAKind   HandType = 11
 	HandTypeRoyalFlush    HandType = 10
 	HandTypeStraightFlush HandType = 9
 	HandTypeFourOfAKind   HandType = 8
@@ -223,6 +232,8 @@ const (
 
 func (h HandType) String() string {
 	switch h {
+	case HandTypeFiveOfAKind:
+		return "Five of a Kind"
 	case HandTypeRoyalFlush:
 		return "Royal Flush"
 	case HandTypeStraightFlush:
@@ -247,22 +258,47 @@ func (h HandType) String() string {
 	return "unknown"
 }
 
-func NewDeck() *Deck {
-	d := &Deck{Cards: make([]Card, 52)}
-	for i := range 13 {
-		d.Cards[i] = Card{UnmarshalRank(i + 2), Hearts}
-		d.Cards[i+13] = Card{UnmarshalRank(i + 2), Clubs}
-		d.Cards[i+26] = Card{UnmarshalRank(i + 2), Diamonds}
-		d.Cards[i+39] = Card{UnmarshalRank(i + 2), Spades}
-	}
-	return d
+// NewDeck returns an unshuffled standard 52-card deck.
+// Options such as WithShortDeck or WithJokers change its composition.
+func NewDeck(opts ...DeckOption) *Deck {
+	return newDeck(FullCardSet, opts)
+}
+
+// NewDeckFromCardSet returns an unshuffled deck holding the cards of s
+// instead of the standard 52 cards. Composition options apply on top of s.
+func NewDeckFromCardSet(s CardSet, opts ...DeckOption) *Deck {
+	return newDeck(s, opts)
 }
 
+// CardSet returns the cards remaining in the deck as a set.
+func (d *Deck) CardSet() CardSet {
+	return NewCardSet(d.Cards...)
+}
+
+// Shuffle shuffles the remaining cards.
+// It uses the source given by WithRandSource, or the global random source otherwise.
 func (d *Deck) Shuffle() {
-	rand.NewSource(time.Now().UnixNano())
-	rand.Shuffle(len(d.Cards), func(i, j int) {
+	swap := func(i, j int) {
 		d.Cards[i], d.Cards[j] = d.Cards[j], d.Cards[i]
-	})
+	}
+	if d.rand != nil {
+		d.rand.Shuffle(len(d.Cards), swap)
+		return
+	}
+	rand.Shuffle(len(d.Cards), swap)
+}
+
+// Clone returns a copy of the deck that can be dealt from independently.
+// If the deck shuffles with a *rand.PCG or *rand.ChaCha8, the clone gets a copy of it
+// in its current state, so both decks shuffle the same way from here on and can be
+// shuffled in different goroutines. Any other source cannot be copied and is shared:
+// shuffling either deck advances it, so the decks must not be shuffled concurrently.
+func (d *Deck) Clone() *Deck {
+	cards := append([]Card(nil), d.Cards...)
+	if src := cloneSource(d.src); src != nil {
+		return newDeckFromCards(cards, src)
+	}
+	return &Deck{Cards: cards, src: d.src, rand: d.rand}
 }
 
 func (d *Deck) DrawCard() Card {
@@ -289,6 +325,19 @@ func (d *Deck) RemoveCard(card Card) bool {
 	return false
 }
 
+// RemoveCardSet removes every card in s from the deck and returns the number of cards removed.
+func (d *Deck) RemoveCardSet(s CardSet) int {
+	kept := d.Cards[:0]
+	for _, c := range d.Cards {
+		if !s.Contains(c) {
+			kept = append(kept, c)
+		}
+	}
+	removed := len(d.Cards) - len(kept)
+	d.Cards = kept
+	return removed
+}
+
 func removeCards(cards []Card, c Card) []Card {
 	for i, card := range cards {
 		if card.Rank == c.Rank && card.Suit == c.Suit {
@@ -311,6 +360,8 @@ func NewPlayer(name string, hand []Card) *Player {
 	}
 }
 
+// Evaluate returns the best hand of the player on board, which may be the flop, the turn or the river.
+// Any of the cards may be used, as in hold'em; use EvaluateOmaha for Omaha.
 func (p *Player) Evaluate(board []Card) (HandType, []Card, error) {
 	cards := append(p.Hand, board...)
 	return Evaluate(cards)
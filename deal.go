@@ -0,0 +1,182 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotEnoughCards is returned when a deck cannot supply the requested cards.
+var ErrNotEnoughCards = errors.New("not enough cards in deck")
+
+// Draw removes and returns the top card of the deck.
+// Unlike DrawCard, it returns ErrNotEnoughCards instead of panicking on an empty deck.
+func (d *Deck) Draw() (Card, error) {
+	cards, err := d.DrawN(1)
+	if err != nil {
+		return Card{}, err
+	}
+	return cards[0], nil
+}
+
+// DrawN removes and returns the top n cards of the deck.
+// The deck is left untouched if it holds fewer than n cards.
+func (d *Deck) DrawN(n int) ([]Card, error) {
+	cards, err := d.Peek(n)
+	if err != nil {
+		return nil, err
+	}
+	d.Cards = d.Cards[n:]
+	return cards, nil
+}
+
+// Peek returns the top n cards of the deck without removing them.
+func (d *Deck) Peek(n int) ([]Card, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid number of cards: %d", n)
+	}
+	if n > len(d.Cards) {
+		return nil, fmt.Errorf("%w: want %d, have %d", ErrNotEnoughCards, n, len(d.Cards))
+	}
+	return append([]Card(nil), d.Cards[:n]...), nil
+}
+
+// DealTarget is where a dealt card went.
+type DealTarget int
+
+const (
+	DealTargetSeat DealTarget = iota
+	DealTargetBoard
+	DealTargetBurn
+)
+
+func (t DealTarget) String() string {
+	switch t {
+	case DealTargetSeat:
+		return "seat"
+	case DealTargetBoard:
+		return "board"
+	case DealTargetBurn:
+		return "burn"
+	default:
+		return "unknown"
+	}
+}
+
+// DealtCard records a single card dealt by a Dealer.
+// Seat is only meaningful when Target is DealTargetSeat.
+type DealtCard struct {
+	Card   Card
+	Target DealTarget
+	Seat   int
+}
+
+// Dealer deals from a deck the way a dealer at a table does and
+// records where every card went, so a hand can be reconstructed afterwards.
+type Dealer struct {
+	deck    *Deck
+	history []DealtCard
+}
+
+// NewDealer returns a Dealer that deals from deck.
+func NewDealer(deck *Deck) *Dealer {
+	return &Dealer{deck: deck}
+}
+
+// Deck returns the deck the dealer deals from.
+func (dl *Dealer) Deck() *Deck {
+	return dl.deck
+}
+
+// Peek returns the top n cards of the deck without dealing them.
+func (dl *Dealer) Peek(n int) ([]Card, error) {
+	return dl.deck.Peek(n)
+}
+
+// Burn discards the top card of the deck face down.
+func (dl *Dealer) Burn() (Card, error) {
+	c, err := dl.deck.Draw()
+	if err != nil {
+		return Card{}, fmt.Errorf("failed to burn: %w", err)
+	}
+	dl.history = append(dl.history, DealtCard{Card: c, Target: DealTargetBurn})
+	return c, nil
+}
+
+// DealSeats deals n cards to each of seats seats one at a time in rotation,
+// starting from seat 0. It returns the cards of each seat in the order received.
+// Nothing is dealt if the deck holds fewer than seats*n cards.
+func (dl *Dealer) DealSeats(seats, n int) ([][]Card, error) {
+	if seats <= 0 || n < 0 {
+		return nil, fmt.Errorf("invalid deal: %d seats, %d cards each", seats, n)
+	}
+	order := make([]int, seats)
+	for i := range order {
+		order[i] = i
+	}
+	return dl.dealRotation(order, n)
+}
+
+// dealRotation deals n cards to each seat of seats one at a time in rotation,
+// in the order of seats, and returns the cards in the same order.
+func (dl *Dealer) dealRotation(seats []int, n int) ([][]Card, error) {
+	cards, err := dl.deck.DrawN(len(seats) * n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deal %d cards to %d seats: %w", n, len(seats), err)
+	}
+
+	hands := make([][]Card, len(seats))
+	for i, c := range cards {
+		j := i % len(seats)
+		hands[j] = append(hands[j], c)
+		dl.history = append(dl.history, DealtCard{Card: c, Target: DealTargetSeat, Seat: seats[j]})
+	}
+	return hands, nil
+}
+
+// DealBoard deals n community cards face up.
+func (dl *Dealer) DealBoard(n int) ([]Card, error) {
+	cards, err := dl.deck.DrawN(n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deal %d board cards: %w", n, err)
+	}
+	for _, c := range cards {
+		dl.history = append(dl.history, DealtCard{Card: c, Target: DealTargetBoard})
+	}
+	return cards, nil
+}
+
+// History returns every card dealt so far in the order it was dealt.
+func (dl *Dealer) History() []DealtCard {
+	return append([]DealtCard(nil), dl.history...)
+}
+
+// SeatCards returns the cards dealt to seat.
+func (dl *Dealer) SeatCards(seat int) []Card {
+	var cards []Card
+	for _, h := range dl.history {
+		if h.Target == DealTargetSeat && h.Seat == seat {
+			cards = append(cards, h.Card)
+		}
+	}
+	return cards
+}
+
+// Board returns the community cards dealt so far.
+func (dl *Dealer) Board() []Card {
+	return dl.cardsTo(DealTargetBoard)
+}
+
+// Burned returns the burn cards discarded so far.
+func (dl *Dealer) Burned() []Card {
+	return dl.cardsTo(DealTargetBurn)
+}
+
+func (dl *Dealer) cardsTo(target DealTarget) []Card {
+	var cards []Card
+	for _, h := range dl.history {
+		if h.Target == target {
+			cards = append(cards, h.Card)
+		}
+	}
+	return cards
+}
@@ -0,0 +1,108 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestDeck_Draw(t *testing.T) {
+	deck := &poker.Deck{Cards: poker.MustParseCards("Ah Kd Qs")}
+
+	peek, err := deck.Peek(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("Ah Kd"), peek); diff != "" {
+		t.Errorf("Peek() mismatch (-want +got):\n%s", diff)
+	}
+	if len(deck.Cards) != 3 {
+		t.Errorf("Peek() removed cards, deck has %d", len(deck.Cards))
+	}
+
+	if _, err := deck.DrawN(4); !errors.Is(err, poker.ErrNotEnoughCards) {
+		t.Errorf("DrawN(4) error = %v, want %v", err, poker.ErrNotEnoughCards)
+	}
+	if len(deck.Cards) != 3 {
+		t.Errorf("failed DrawN() removed cards, deck has %d", len(deck.Cards))
+	}
+	if _, err := deck.DrawN(-1); err == nil {
+		t.Error("expected error for negative count, got nil")
+	}
+
+	got, err := deck.DrawN(3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("Ah Kd Qs"), got); diff != "" {
+		t.Errorf("DrawN() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := deck.Draw(); !errors.Is(err, poker.ErrNotEnoughCards) {
+		t.Errorf("Draw() error = %v, want %v", err, poker.ErrNotEnoughCards)
+	}
+}
+
+func TestDealer(t *testing.T) {
+	deck := &poker.Deck{Cards: poker.MustParseCards("2c 3c 4c 5c 6c 7c 8c 9c Tc Jc Qc Kc")}
+	dl := poker.NewDealer(deck)
+
+	hands, err := dl.DealSeats(3, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wantHands := [][]poker.Card{
+		poker.MustParseCards("2c 5c"),
+		poker.MustParseCards("3c 6c"),
+		poker.MustParseCards("4c 7c"),
+	}
+	if diff := cmp.Diff(wantHands, hands); diff != "" {
+		t.Errorf("DealSeats() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := dl.Burn(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	flop, err := dl.DealBoard(3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("9c Tc Jc"), flop); diff != "" {
+		t.Errorf("DealBoard() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := dl.DealSeats(3, 1); !errors.Is(err, poker.ErrNotEnoughCards) {
+		t.Errorf("DealSeats() error = %v, want %v", err, poker.ErrNotEnoughCards)
+	}
+	if _, err := dl.Burn(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := dl.DealBoard(1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := dl.Burn(); !errors.Is(err, poker.ErrNotEnoughCards) {
+		t.Errorf("Burn() error = %v, want %v", err, poker.ErrNotEnoughCards)
+	}
+
+	if diff := cmp.Diff(poker.MustParseCards("3c 6c"), dl.SeatCards(1)); diff != "" {
+		t.Errorf("SeatCards() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("9c Tc Jc Kc"), dl.Board()); diff != "" {
+		t.Errorf("Board() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("8c Qc"), dl.Burned()); diff != "" {
+		t.Errorf("Burned() mismatch (-want +got):\n%s", diff)
+	}
+
+	history := dl.History()
+	if len(history) != 12 {
+		t.Fatalf("History() has %d entries, want 12", len(history))
+	}
+	if want := (poker.DealtCard{Card: poker.Card{Rank: poker.RankFour, Suit: poker.Clubs}, Target: poker.DealTargetSeat, Seat: 2}); history[2] != want {
+		t.Errorf("History()[2] = %+v, want %+v", history[2], want)
+	}
+	if history[6].Target != poker.DealTargetBurn {
+		t.Errorf("History()[6].Target = %s, want burn", history[6].Target)
+	}
+}
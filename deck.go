@@ -0,0 +1,127 @@
+package poker
+
+import (
+	"encoding"
+	"math/rand/v2"
+)
+
+// Jokers are told apart by suit so that a deck can hold two distinct jokers.
+// No other card has RankJoker.
+var (
+	RedJoker   = Card{Rank: RankJoker, Suit: Hearts}
+	BlackJoker = Card{Rank: RankJoker, Suit: Spades}
+)
+
+// isJoker reports whether c is RedJoker or BlackJoker.
+func isJoker(c Card) bool {
+	return c == RedJoker || c == BlackJoker
+}
+
+const maxJokers = 2
+
+// DeckOption configures a Deck created by NewDeck or NewDeckFromCardSet.
+type DeckOption func(*deckConfig)
+
+type deckConfig struct {
+	src     rand.Source
+	removed CardSet
+	jokers  int
+	decks   int
+}
+
+// WithRandSource makes the deck shuffle with src instead of the global random source,
+// so the same seed always produces the same deal.
+func WithRandSource(src rand.Source) DeckOption {
+	return func(c *deckConfig) {
+		c.src = src
+	}
+}
+
+// WithShortDeck builds a 36-card short deck by removing the deuces through fives.
+func WithShortDeck() DeckOption {
+	return WithoutRanks(RankDeuce, RankThree, RankFour, RankFive)
+}
+
+// WithoutRanks removes every card of the given ranks from the deck.
+func WithoutRanks(ranks ...Rank) DeckOption {
+	return func(c *deckConfig) {
+		for _, r := range ranks {
+			c.removed = c.removed.Union(rankCardSet(r))
+		}
+	}
+}
+
+// WithJokers adds n jokers to the deck. At most two jokers are added:
+// RedJoker first, then BlackJoker.
+func WithJokers(n int) DeckOption {
+	return func(c *deckConfig) {
+		c.jokers = max(0, min(n, maxJokers))
+	}
+}
+
+// WithDecks builds a shoe of n decks, one after another in the order of a single deck.
+// The resulting deck holds n copies of every card; call Shuffle to mix them.
+// The equity and outs calculations assume distinct cards, so WithDeck rejects shoes of
+// more than one deck.
+func WithDecks(n int) DeckOption {
+	return func(c *deckConfig) {
+		c.decks = max(1, n)
+	}
+}
+
+func newDeck(base CardSet, opts []DeckOption) *Deck {
+	cfg := deckConfig{decks: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	single := base.Difference(cfg.removed).Cards()
+	single = append(single, []Card{RedJoker, BlackJoker}[:cfg.jokers]...)
+
+	cards := make([]Card, 0, len(single)*cfg.decks)
+	for range cfg.decks {
+		cards = append(cards, single...)
+	}
+	return newDeckFromCards(cards, cfg.src)
+}
+
+func newDeckFromCards(cards []Card, src rand.Source) *Deck {
+	d := &Deck{Cards: cards, src: src}
+	if src != nil {
+		d.rand = rand.New(src)
+	}
+	return d
+}
+
+// cloneSource returns a copy of src in its current state, or nil if src cannot be copied.
+func cloneSource(src rand.Source) rand.Source {
+	var dst interface {
+		rand.Source
+		encoding.BinaryUnmarshaler
+	}
+	var state []byte
+	var err error
+	switch s := src.(type) {
+	case *rand.PCG:
+		dst = new(rand.PCG)
+		state, err = s.MarshalBinary()
+	case *rand.ChaCha8:
+		dst = new(rand.ChaCha8)
+		state, err = s.MarshalBinary()
+	default:
+		return nil
+	}
+	if err != nil || dst.UnmarshalBinary(state) != nil {
+		return nil
+	}
+	return dst
+}
+
+// rankCardSet returns the four cards of rank r.
+func rankCardSet(r Rank) CardSet {
+	var s CardSet
+	for _, suit := range []Suit{Hearts, Clubs, Diamonds, Spades} {
+		s = s.Add(Card{Rank: r, Suit: suit})
+	}
+	return s
+}
@@ -0,0 +1,203 @@
+package poker_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestDeck_ShuffleWithRandSource(t *testing.T) {
+	d1 := poker.NewDeck(poker.WithRandSource(rand.NewPCG(1, 2)))
+	d2 := poker.NewDeck(poker.WithRandSource(rand.NewPCG(1, 2)))
+	d3 := poker.NewDeck(poker.WithRandSource(rand.NewPCG(3, 4)))
+
+	d1.Shuffle()
+	d2.Shuffle()
+	d3.Shuffle()
+
+	if diff := cmp.Diff(d1.Cards, d2.Cards); diff != "" {
+		t.Errorf("same seed produced different decks (-d1 +d2):\n%s", diff)
+	}
+	if cmp.Equal(d1.Cards, d3.Cards) {
+		t.Error("different seeds produced the same deck")
+	}
+	if cmp.Equal(d1.Cards, poker.NewDeck().Cards) {
+		t.Error("deck was not shuffled")
+	}
+	if d1.CardSet() != poker.FullCardSet {
+		t.Error("shuffled deck lost cards")
+	}
+}
+
+func TestDeck_Clone(t *testing.T) {
+	d := poker.NewDeck(poker.WithRandSource(rand.NewPCG(1, 2)))
+	d.Shuffle()
+	top := d.Cards[0]
+
+	c := d.Clone()
+	if diff := cmp.Diff(d.Cards, c.Cards); diff != "" {
+		t.Errorf("clone mismatch (-want +got):\n%s", diff)
+	}
+
+	c.DrawCards(5)
+	c.Shuffle()
+	if len(d.Cards) != 52 || d.Cards[0] != top {
+		t.Error("changing the clone changed the original deck")
+	}
+	if len(c.Cards) != 47 {
+		t.Errorf("clone has %d cards, want 47", len(c.Cards))
+	}
+
+	for _, src := range []rand.Source{rand.NewPCG(3, 4), rand.NewChaCha8([32]byte{5})} {
+		d := poker.NewDeck(poker.WithRandSource(src))
+		d.Shuffle()
+		c := d.Clone()
+		d.Shuffle()
+		c.Shuffle()
+		if diff := cmp.Diff(d.Cards, c.Cards); diff != "" {
+			t.Errorf("%T: clone does not replay the shuffle (-want +got):\n%s", src, diff)
+		}
+	}
+
+	// A source that cannot be copied is shared, so the same seed still gives the same deals.
+	deal := func() []poker.Card {
+		d := poker.NewDeck(poker.WithRandSource(uint64Source{rand.NewPCG(5, 6)}))
+		d.Shuffle()
+		c := d.Clone()
+		d.Shuffle()
+		c.Shuffle()
+		return append(d.Cards, c.Cards...)
+	}
+	if diff := cmp.Diff(deal(), deal()); diff != "" {
+		t.Errorf("shared source is not reproducible (-first +second):\n%s", diff)
+	}
+}
+
+// uint64Source hides every method of its source except Uint64.
+type uint64Source struct {
+	src rand.Source
+}
+
+func (s uint64Source) Uint64() uint64 {
+	return s.src.Uint64()
+}
+
+func TestNewDeck_Compositions(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []poker.DeckOption
+		wantLen   int
+		wantNot   []poker.Card
+		wantCount map[poker.Card]int
+	}{
+		{
+			name:    "standard",
+			wantLen: 52,
+		},
+		{
+			name:    "short deck",
+			opts:    []poker.DeckOption{poker.WithShortDeck()},
+			wantLen: 36,
+			wantNot: poker.MustParseCards("2h 3c 4d 5s"),
+		},
+		{
+			name:      "one joker",
+			opts:      []poker.DeckOption{poker.WithJokers(1)},
+			wantLen:   53,
+			wantCount: map[poker.Card]int{poker.RedJoker: 1, poker.BlackJoker: 0},
+		},
+		{
+			name:      "two jokers",
+			opts:      []poker.DeckOption{poker.WithJokers(2)},
+			wantLen:   54,
+			wantCount: map[poker.Card]int{poker.RedJoker: 1, poker.BlackJoker: 1},
+		},
+		{
+			name:    "jokers are capped at two",
+			opts:    []poker.DeckOption{poker.WithJokers(5)},
+			wantLen: 54,
+		},
+		{
+			name:    "ranks removed",
+			opts:    []poker.DeckOption{poker.WithoutRanks(poker.RankTen, poker.RankJack)},
+			wantLen: 44,
+			wantNot: poker.MustParseCards("Th Jd"),
+		},
+		{
+			name:      "six-deck shoe",
+			opts:      []poker.DeckOption{poker.WithDecks(6)},
+			wantLen:   312,
+			wantCount: map[poker.Card]int{{Rank: poker.RankAce, Suit: poker.Spades}: 6},
+		},
+		{
+			name:      "options are combined regardless of order",
+			opts:      []poker.DeckOption{poker.WithDecks(2), poker.WithJokers(1), poker.WithShortDeck()},
+			wantLen:   74,
+			wantCount: map[poker.Card]int{poker.RedJoker: 2, {Rank: poker.RankSix, Suit: poker.Hearts}: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck := poker.NewDeck(tt.opts...)
+			if len(deck.Cards) != tt.wantLen {
+				t.Errorf("deck has %d cards, want %d", len(deck.Cards), tt.wantLen)
+			}
+			counts := make(map[poker.Card]int)
+			for _, c := range deck.Cards {
+				counts[c]++
+			}
+			for _, c := range tt.wantNot {
+				if counts[c] != 0 {
+					t.Errorf("deck contains %s", c)
+				}
+			}
+			for c, want := range tt.wantCount {
+				if counts[c] != want {
+					t.Errorf("deck has %d of %s, want %d", counts[c], c, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateEquity_WithDeck(t *testing.T) {
+	players := []poker.Player{
+		{Name: "player1", Hand: poker.MustParseCards("Kh Qd")},
+		{Name: "player2", Hand: poker.MustParseCards("Ah Ad")},
+	}
+	community := poker.MustParseCards("Js Ts 6c 7c")
+
+	// Player1 makes a straight with the four nines and the two remaining aces.
+	// 28 river cards remain in a short deck and 44 in a full deck.
+	short, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community, poker.WithDeck(poker.NewDeck(poker.WithShortDeck())))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	full, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]float64{6.0 / 28, 22.0 / 28}, short); diff != "" {
+		t.Errorf("short deck mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]float64{6.0 / 44, 38.0 / 44}, full); diff != "" {
+		t.Errorf("full deck mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community, poker.WithDeck(poker.NewDeck(poker.WithJokers(1)))); err == nil {
+		t.Error("expected error for a deck with jokers, got nil")
+	}
+	if _, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community, poker.WithDeck(poker.NewDeck(poker.WithDecks(2)))); err == nil {
+		t.Error("expected error for a two-deck shoe, got nil")
+	}
+
+	low := []poker.Player{{Name: "low", Hand: poker.MustParseCards("2h 7h")}, players[1]}
+	var notInDeck *poker.CardNotInDeckError
+	if _, err := poker.EvaluateEquity(low, poker.WithDeck(poker.NewDeck(poker.WithShortDeck()))); !errors.As(err, &notInDeck) || notInDeck.Card != poker.MustParseCards("2h")[0] {
+		t.Errorf("expected *CardNotInDeckError for 2h, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+var rankNames = [...]string{
+	RankDeuce: "Deuce",
+	RankThree: "Three",
+	RankFour:  "Four",
+	RankFive:  "Five",
+	RankSix:   "Six",
+	RankSeven: "Seven",
+	RankEight: "Eight",
+	RankNine:  "Nine",
+	RankTen:   "Ten",
+	RankJack:  "Jack",
+	RankQueen: "Queen",
+	RankKing:  "King",
+	RankAce:   "Ace",
+}
+
+// Name returns the English name of the rank such as "Queen".
+func (r Rank) Name() string {
+	if r == RankJoker {
+		return "Joker"
+	}
+	if r < RankDeuce || r > RankAce {
+		return "Unknown"
+	}
+	return rankNames[r]
+}
+
+// PluralName returns the plural English name of the rank such as "Queens" or "Sixes".
+func (r Rank) PluralName() string {
+	if r == RankSix {
+		return "Sixes"
+	}
+	return r.Name() + "s"
+}
+
+// Describe returns the hand in words with every rank that breaks ties, e.g.
+// "Two Pair, Kings and Nines with Ace kicker", "Straight, Five high (wheel)"
+// or "Flush, Ace-Queen-Nine-Six-Three high".
+func (r HandRank) Describe() string {
+	return r.describe(true)
+}
+
+// DescribeShort returns the hand in words without kickers, e.g. "Two Pair, Kings and Nines",
+// "Straight, Five high" or "Flush, Ace-Queen high". Flushes name their two highest cards.
+func (r HandRank) DescribeShort() string {
+	return r.describe(false)
+}
+
+func (r HandRank) describe(long bool) string {
+	category := r.Category()
+	k := r.Kickers()
+	// withKickers returns the ranks from i on as kickers in the long form.
+	withKickers := func(i int) string {
+		if !long || len(k) <= i {
+			return ""
+		}
+		if len(k) == i+1 {
+			return fmt.Sprintf(" with %s kicker", k[i].Name())
+		}
+		return fmt.Sprintf(" with %s kickers", joinRankNames(k[i:]))
+	}
+
+	if category == HandTypeRoyalFlush || len(k) == 0 {
+		return category.String()
+	}
+
+	switch category {
+	case HandTypeStraightFlush, HandTypeStraight:
+		s := fmt.Sprintf("%s, %s high", category, k[0].Name())
+		if long {
+			s += straightNickname(category, k[0])
+		}
+		return s
+	case HandTypeFiveOfAKind, HandTypeFourOfAKind, HandTypeThreeOfAKind, HandTypePair:
+		return fmt.Sprintf("%s, %s", category, k[0].PluralName()) + withKickers(1)
+	case HandTypeFullHouse:
+		if len(k) < 2 {
+			return fmt.Sprintf("%s, %s full", category, k[0].PluralName())
+		}
+		return fmt.Sprintf("%s, %s full of %s", category, k[0].PluralName(), k[1].PluralName())
+	case HandTypeTwoPair:
+		if len(k) < 2 {
+			return fmt.Sprintf("%s, %s", category, k[0].PluralName())
+		}
+		return fmt.Sprintf("%s, %s and %s", category, k[0].PluralName(), k[1].PluralName()) + withKickers(2)
+	case HandTypeFlush, HandTypeHighCard:
+		switch {
+		case long:
+			return fmt.Sprintf("%s, %s high", category, joinRankNames(k))
+		case category == HandTypeFlush:
+			return fmt.Sprintf("%s, %s high", category, joinRankNames(k[:min(2, len(k))]))
+		default:
+			return fmt.Sprintf("%s, %s high", category, k[0].Name())
+		}
+	default:
+		return category.String()
+	}
+}
+
+func straightNickname(category HandType, high Rank) string {
+	switch {
+	case high == RankFive && category == HandTypeStraightFlush:
+		return " (steel wheel)"
+	case high == RankFive:
+		return " (wheel)"
+	case high == RankAce && category == HandTypeStraight:
+		return " (Broadway)"
+	default:
+		return ""
+	}
+}
+
+func joinRankNames(ranks []Rank) string {
+	names := make([]string, 0, len(ranks))
+	for _, r := range ranks {
+		names = append(names, r.Name())
+	}
+	return strings.Join(names, "-")
+}
+
+// Describe returns the made hand in words like HandRank.Describe.
+func (hand *MadeHand) Describe() string {
+	return hand.Rank().Describe()
+}
+
+// DescribeShort returns the made hand in words like HandRank.DescribeShort.
+func (hand *MadeHand) DescribeShort() string {
+	return hand.Rank().DescribeShort()
+}
@@ -0,0 +1,53 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/whywaita/poker-go"
+)
+
+func TestHandRank_Describe(t *testing.T) {
+	tests := []struct {
+		cards     string
+		wantLong  string
+		wantShort string
+	}{
+		{cards: "Ah Kh Qh Jh Th 2c 3d", wantLong: "Royal Flush", wantShort: "Royal Flush"},
+		{cards: "9s 8s 7s 6s 5s 2c 3d", wantLong: "Straight Flush, Nine high", wantShort: "Straight Flush, Nine high"},
+		{cards: "5s 4s 3s 2s As Kd Qc", wantLong: "Straight Flush, Five high (steel wheel)", wantShort: "Straight Flush, Five high"},
+		{cards: "9s 9h 9d 9c Kd 2c 3d", wantLong: "Four of a Kind, Nines with King kicker", wantShort: "Four of a Kind, Nines"},
+		{cards: "6s 6h 6d 4c 4d Ac 2d", wantLong: "Full House, Sixes full of Fours", wantShort: "Full House, Sixes full of Fours"},
+		{cards: "Ah Qh 9h 6h 3h Kc 2d", wantLong: "Flush, Ace-Queen-Nine-Six-Three high", wantShort: "Flush, Ace-Queen high"},
+		{cards: "Ac Kd Qh Js Tc 2d 3s", wantLong: "Straight, Ace high (Broadway)", wantShort: "Straight, Ace high"},
+		{cards: "5c 4d 3h 2s Ac Kd 9c", wantLong: "Straight, Five high (wheel)", wantShort: "Straight, Five high"},
+		{cards: "7s 7h 7d Ac Kd 2c 3d", wantLong: "Three of a Kind, Sevens with Ace-King kickers", wantShort: "Three of a Kind, Sevens"},
+		{cards: "Ks Kd 9h 9c Ad 3s 4c", wantLong: "Two Pair, Kings and Nines with Ace kicker", wantShort: "Two Pair, Kings and Nines"},
+		{cards: "2s 2d 9h 7c Jd 3s 4c", wantLong: "Pair, Deuces with Jack-Nine-Seven kickers", wantShort: "Pair, Deuces"},
+		{cards: "As Jd 9h 7c 2d 3s 4c", wantLong: "High Card, Ace-Jack-Nine-Seven-Four high", wantShort: "High Card, Ace high"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantLong, func(t *testing.T) {
+			cards := poker.MustParseCards(tt.cards)
+			handType, hand, err := poker.Evaluate(cards)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			rank := poker.NewHandRankFromHand(handType, hand)
+			if got := rank.Describe(); got != tt.wantLong {
+				t.Errorf("Describe() = %q, want %q", got, tt.wantLong)
+			}
+			if got := rank.DescribeShort(); got != tt.wantShort {
+				t.Errorf("DescribeShort() = %q, want %q", got, tt.wantShort)
+			}
+
+			md := poker.NewBestMadeHand(poker.MustParseCards(tt.cards))
+			if got := md.Describe(); got != tt.wantLong {
+				t.Errorf("MadeHand.Describe() = %q, want %q", got, tt.wantLong)
+			}
+			if got := md.DescribeShort(); got != tt.wantShort {
+				t.Errorf("MadeHand.DescribeShort() = %q, want %q", got, tt.wantShort)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Evaluator ranks the best hand that can be made from cards, such as hole cards plus a board.
+// A greater HandRank must be a stronger hand; equal ranks split the pot.
+// Evaluate must not modify or retain cards.
+//
+// Evaluators for other games, such as AceToFiveEvaluator, return their own rank type,
+// e.g. a LowRank, converted with HandRank(rank). Such a HandRank orders hands correctly
+// but is decoded by converting it back, e.g. with LowRank(r).
+//
+// The comparison and equity functions reject jokers unless the evaluator also implements
+// JokerAccepter and AcceptsJokers returns true.
+type Evaluator interface {
+	Evaluate(cards []Card) (HandRank, error)
+}
+
+// JokerAccepter is implemented by evaluators that play jokers, such as WildEvaluator.
+type JokerAccepter interface {
+	AcceptsJokers() bool
+}
+
+// EvaluatorFunc adapts an ordinary function to the Evaluator interface.
+type EvaluatorFunc func(cards []Card) (HandRank, error)
+
+// Evaluate calls f(cards).
+func (f EvaluatorFunc) Evaluate(cards []Card) (HandRank, error) {
+	return f(cards)
+}
+
+// Evaluators shipped with the package. Both accept 5 to 7 cards and return the same ranks.
+var (
+	// RuleEvaluator checks each hand type in turn like Evaluate.
+	RuleEvaluator Evaluator = ruleEvaluator{}
+	// LookupEvaluator looks 7-card hands up in precalculated tables like NewBestMadeHand,
+	// which is much faster, and falls back to RuleEvaluator for 5 or 6 cards.
+	LookupEvaluator Evaluator = lookupEvaluator{}
+)
+
+type ruleEvaluator struct{}
+
+func (ruleEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateRank(cards)
+	return rank, err
+}
+
+type lookupEvaluator struct{}
+
+func (lookupEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	if len(cards) != 7 {
+		return RuleEvaluator.Evaluate(cards)
+	}
+	if err := validateHand(cards); err != nil {
+		return 0, err
+	}
+	return NewBestMadeHand(cards).Rank(), nil
+}
+
+// WithEvaluator ranks hands with e instead of the default evaluator of the function.
+func WithEvaluator(e Evaluator) Option {
+	return func(o *options) {
+		o.evaluator = e
+	}
+}
+
+// compareFunc returns the winner(s) among players on board.
+type compareFunc func(players []Player, board []Card) ([]Player, error)
+
+// compare returns the winner(s) with the evaluator given by WithEvaluator, or with def otherwise.
+func (o *options) compare(def compareFunc) compareFunc {
+	if o.evaluator == nil {
+		return def
+	}
+	return func(players []Player, board []Card) ([]Player, error) {
+		return compareHandsWith(o.evaluator, players, board)
+	}
+}
+
+// evaluatorOr returns the evaluator given by WithEvaluator, or def otherwise.
+func (o *options) evaluatorOr(def Evaluator) Evaluator {
+	if o.evaluator == nil {
+		return def
+	}
+	return o.evaluator
+}
+
+// compareHandsWith returns the winner(s) among players on board as ranked by e.
+// Like compareHands, tied players come first. Score is left as is since the rank
+// of a custom evaluator, e.g. a low, does not always decode to a HandType.
+func compareHandsWith(e Evaluator, players []Player, board []Card) ([]Player, error) {
+	var best Player
+	var bestRank HandRank
+	var tied []Player
+
+	cards := make([]Card, 0, 7)
+	for i, p := range players {
+		cards = append(append(cards[:0], p.Hand...), board...)
+		rank, err := e.Evaluate(cards)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate %s's hand: %w", p.Name, err)
+		}
+
+		switch {
+		case i == 0 || rank > bestRank:
+			best, bestRank = p, rank
+			tied = nil
+		case rank == bestRank:
+			tied = append(tied, p)
+		}
+	}
+
+	if len(tied) == 0 {
+		return []Player{best}, nil
+	}
+	return append(slices.Clip(tied), best), nil
+}
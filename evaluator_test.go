@@ -0,0 +1,130 @@
+package poker_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluators_Agree(t *testing.T) {
+	deck := poker.NewDeck(poker.WithRandSource(rand.NewPCG(1, 2)))
+	for range 2000 {
+		deck.Shuffle()
+		for _, n := range []int{5, 6, 7} {
+			cards := deck.Cards[:n]
+			want, err := poker.RuleEvaluator.Evaluate(cards)
+			if err != nil {
+				t.Fatalf("RuleEvaluator: %s", err)
+			}
+			got, err := poker.LookupEvaluator.Evaluate(cards)
+			if err != nil {
+				t.Fatalf("LookupEvaluator: %s", err)
+			}
+			if got != want {
+				t.Fatalf("cards %s: LookupEvaluator = %s, RuleEvaluator = %s", poker.Cards(cards), got, want)
+			}
+		}
+	}
+}
+
+func TestEvaluators_InvalidCards(t *testing.T) {
+	for name, e := range map[string]poker.Evaluator{"rule": poker.RuleEvaluator, "lookup": poker.LookupEvaluator} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := e.Evaluate(poker.MustParseCards("Ah Kd Qs Jc 9h")); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			_, err := e.Evaluate([]poker.Card{
+				{Rank: poker.RankAce, Suit: poker.Hearts},
+				{Rank: poker.RankAce, Suit: poker.Hearts},
+				{Rank: poker.RankKing, Suit: poker.Hearts},
+				{Rank: poker.RankQueen, Suit: poker.Hearts},
+				{Rank: poker.RankJack, Suit: poker.Hearts},
+				{Rank: poker.RankTen, Suit: poker.Hearts},
+				{Rank: poker.RankNine, Suit: poker.Hearts},
+			})
+			var conflict *poker.CardConflictError
+			if !errors.As(err, &conflict) {
+				t.Errorf("expected *CardConflictError, got %v", err)
+			}
+		})
+	}
+}
+
+// worstHandWins ranks hands in reverse, so the weakest hand wins.
+var worstHandWins = poker.EvaluatorFunc(func(cards []poker.Card) (poker.HandRank, error) {
+	rank, err := poker.RuleEvaluator.Evaluate(cards)
+	return ^rank, err
+})
+
+func TestWithEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "aces", Hand: poker.MustParseCards("Ah Ad")},
+		{Name: "deuces", Hand: poker.MustParseCards("2c 2s")},
+		{Name: "junk", Hand: poker.MustParseCards("7h 3d")},
+	}
+	board := poker.MustParseCards("Kc 9d 8s 5h 4c")
+
+	winners, err := poker.CompareHands(players, board, poker.WithEvaluator(worstHandWins))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(winners) != 1 || winners[0].Name != "junk" {
+		t.Errorf("CompareHands() winners = %v, want junk", winners)
+	}
+
+	winners, err = poker.CompareHandsByMadeHand(players, board, poker.WithEvaluator(worstHandWins))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(winners) != 1 || winners[0].Name != "junk" {
+		t.Errorf("CompareHandsByMadeHand() winners = %v, want junk", winners)
+	}
+
+	equities, err := poker.EvaluateEquityByMadeHandWithCommunity(players, board, poker.WithEvaluator(worstHandWins))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]float64{0, 0, 1}, equities); diff != "" {
+		t.Errorf("equity mismatch (-want +got):\n%s", diff)
+	}
+
+	ranked, err := poker.RankPlayers(players, board, poker.WithEvaluator(worstHandWins))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	names := make([]string, 0, len(ranked))
+	for _, r := range ranked {
+		names = append(names, r.Player.Name)
+	}
+	if diff := cmp.Diff([]string{"junk", "deuces", "aces"}, names); diff != "" {
+		t.Errorf("RankPlayers() order mismatch (-want +got):\n%s", diff)
+	}
+
+	// A six gives the junk hand a straight, which leaves the aces as the weakest hand.
+	outs, err := poker.CalculateOuts(players[0].Hand, board[:4], [][]poker.Card{players[2].Hand}, poker.WithEvaluator(worstHandWins))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(poker.MustParseCards("6h 6c 6d 6s"), outs); diff != "" {
+		t.Errorf("CalculateOuts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWithEvaluator_Errors(t *testing.T) {
+	wantErr := errors.New("boom")
+	failing := poker.EvaluatorFunc(func([]poker.Card) (poker.HandRank, error) {
+		return 0, wantErr
+	})
+	players := []poker.Player{
+		{Name: "p1", Hand: poker.MustParseCards("Ah Ad")},
+		{Name: "p2", Hand: poker.MustParseCards("2c 2s")},
+	}
+	_, err := poker.EvaluateEquity(slices.Clone(players), poker.WithEvaluator(failing))
+	if !errors.Is(err, wantErr) {
+		t.Errorf("EvaluateEquity() error = %v, want %v", err, wantErr)
+	}
+}
@@ -14,19 +14,10 @@ func main() {
 }
 
 func run() error {
-	h1 := []poker.Card{
-		{Rank: poker.RankAce, Suit: poker.Hearts},
-		{Rank: poker.RankAce, Suit: poker.Diamonds},
-	}
+	h1 := poker.MustParseCards("Ah Ad")
 	p1 := poker.NewPlayer("player1", h1)
 
-	board := []poker.Card{
-		{Rank: poker.RankAce, Suit: poker.Clubs},
-		{Rank: poker.RankAce, Suit: poker.Spades},
-		{Rank: poker.RankKing, Suit: poker.Hearts},
-		{Rank: poker.RankQueen, Suit: poker.Hearts},
-		{Rank: poker.RankJack, Suit: poker.Hearts},
-	}
+	board := poker.MustParseCards("Ac As Kh Qh Jh")
 
 	handtype, cards, err := poker.Evaluate(append(p1.Hand, board...))
 	if err != nil {
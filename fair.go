@@ -0,0 +1,126 @@
+package poker
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	mrand "math/rand/v2"
+	"slices"
+)
+
+const fairSeedSize = 32
+
+var (
+	// ErrCommitmentMismatch is returned by VerifyShuffle when the revealed server seed
+	// does not hash to the published commitment.
+	ErrCommitmentMismatch = errors.New("server seed does not match commitment")
+	// ErrDeckOrderMismatch is returned by VerifyShuffle when the revealed deck order
+	// cannot be recomputed from the revealed seeds.
+	ErrDeckOrderMismatch = errors.New("deck order does not match seeds")
+)
+
+// FairShuffle is a provably fair shuffle based on a commit/reveal scheme.
+//
+// The server publishes Commitment before the hand starts. Players may then
+// contribute a client seed, so the server cannot choose the deck order alone.
+// After the hand, the server publishes Reveal and anyone can recompute the
+// deck order offline with VerifyShuffle.
+//
+// The deck order is derived as follows, so it can be reimplemented outside Go:
+// the key HMAC-SHA256(serverSeed, clientSeed) seeds a ChaCha8 generator, and
+// the cards of NewDeck are shuffled by Fisher-Yates from the last position
+// down, drawing each index j in [0, i] by rejection sampling on Uint64.
+type FairShuffle struct {
+	serverSeed []byte
+}
+
+// ShuffleReveal holds everything needed to verify a FairShuffle after the hand.
+type ShuffleReveal struct {
+	ServerSeed []byte `json:"serverSeed"`
+	ClientSeed []byte `json:"clientSeed"`
+	Cards      []Card `json:"cards"`
+}
+
+// NewFairShuffle returns a FairShuffle with a server seed from crypto/rand.
+func NewFairShuffle() (*FairShuffle, error) {
+	seed := make([]byte, fairSeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate server seed: %w", err)
+	}
+	return &FairShuffle{serverSeed: seed}, nil
+}
+
+// NewFairShuffleWithSeed returns a FairShuffle with the given server seed.
+// It is intended for tests and for replaying a revealed hand.
+func NewFairShuffleWithSeed(serverSeed []byte) (*FairShuffle, error) {
+	if len(serverSeed) == 0 {
+		return nil, fmt.Errorf("server seed must not be empty")
+	}
+	return &FairShuffle{serverSeed: slices.Clone(serverSeed)}, nil
+}
+
+// Commitment returns the hex-encoded SHA-256 hash of the server seed.
+// It must be published before the client seed is chosen.
+func (f *FairShuffle) Commitment() string {
+	return shuffleCommitment(f.serverSeed)
+}
+
+// Deck returns a deck shuffled from the server seed and clientSeed.
+// clientSeed may be empty when players do not contribute one.
+func (f *FairShuffle) Deck(clientSeed []byte) *Deck {
+	return &Deck{Cards: fairDeckOrder(f.serverSeed, clientSeed)}
+}
+
+// Reveal returns the seeds and the deck order to publish after the hand.
+func (f *FairShuffle) Reveal(clientSeed []byte) ShuffleReveal {
+	return ShuffleReveal{
+		ServerSeed: slices.Clone(f.serverSeed),
+		ClientSeed: slices.Clone(clientSeed),
+		Cards:      fairDeckOrder(f.serverSeed, clientSeed),
+	}
+}
+
+// VerifyShuffle checks that reveal matches the commitment published before the hand
+// and that its deck order is the one derived from the revealed seeds.
+func VerifyShuffle(commitment string, reveal ShuffleReveal) error {
+	if !hmac.Equal([]byte(shuffleCommitment(reveal.ServerSeed)), []byte(commitment)) {
+		return ErrCommitmentMismatch
+	}
+	if !slices.Equal(fairDeckOrder(reveal.ServerSeed, reveal.ClientSeed), reveal.Cards) {
+		return ErrDeckOrderMismatch
+	}
+	return nil
+}
+
+func shuffleCommitment(serverSeed []byte) string {
+	sum := sha256.Sum256(serverSeed)
+	return hex.EncodeToString(sum[:])
+}
+
+func fairDeckOrder(serverSeed, clientSeed []byte) []Card {
+	mac := hmac.New(sha256.New, serverSeed)
+	mac.Write(clientSeed)
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+	rng := mrand.NewChaCha8(key)
+
+	cards := NewDeck().Cards
+	for i := len(cards) - 1; i > 0; i-- {
+		j := uniformIndex(rng, uint64(i+1))
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+	return cards
+}
+
+// uniformIndex returns a uniform value in [0, n) without modulo bias.
+func uniformIndex(rng *mrand.ChaCha8, n uint64) uint64 {
+	threshold := -n % n
+	for {
+		if v := rng.Uint64(); v >= threshold {
+			return v % n
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestFairShuffle(t *testing.T) {
+	serverSeed := []byte("server-seed")
+	clientSeed := []byte("client-seed")
+
+	fs, err := poker.NewFairShuffleWithSeed(serverSeed)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	commitment := fs.Commitment()
+	if want := "91024ec49c5bec0b689e42892526320fce08337205c91de94c7a588c20d08eeb"; commitment != want {
+		t.Errorf("Commitment() = %s, want %s", commitment, want)
+	}
+
+	deck := fs.Deck(clientSeed)
+	// The derivation is part of the public contract, so pin the first cards.
+	if diff := cmp.Diff(poker.MustParseCards("6h 5h Kh 6c 4s"), deck.DrawCards(5)); diff != "" {
+		t.Errorf("deck order mismatch (-want +got):\n%s", diff)
+	}
+
+	reveal := fs.Reveal(clientSeed)
+	if poker.NewCardSet(reveal.Cards...) != poker.FullCardSet {
+		t.Error("revealed deck is not a full deck")
+	}
+	if err := poker.VerifyShuffle(commitment, reveal); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	other := fs.Reveal([]byte("another-client-seed"))
+	if cmp.Equal(reveal.Cards, other.Cards) {
+		t.Error("client seed did not change the deck order")
+	}
+}
+
+func TestVerifyShuffle_Tampered(t *testing.T) {
+	fs, err := poker.NewFairShuffleWithSeed([]byte("server-seed"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	commitment := fs.Commitment()
+
+	tests := []struct {
+		name   string
+		tamper func(r *poker.ShuffleReveal)
+		want   error
+	}{
+		{
+			name:   "server seed replaced",
+			tamper: func(r *poker.ShuffleReveal) { r.ServerSeed = []byte("other-seed") },
+			want:   poker.ErrCommitmentMismatch,
+		},
+		{
+			name:   "client seed replaced",
+			tamper: func(r *poker.ShuffleReveal) { r.ClientSeed = []byte("other-seed") },
+			want:   poker.ErrDeckOrderMismatch,
+		},
+		{
+			name:   "cards swapped",
+			tamper: func(r *poker.ShuffleReveal) { r.Cards[0], r.Cards[1] = r.Cards[1], r.Cards[0] },
+			want:   poker.ErrDeckOrderMismatch,
+		},
+		{
+			name:   "card removed",
+			tamper: func(r *poker.ShuffleReveal) { r.Cards = r.Cards[1:] },
+			want:   poker.ErrDeckOrderMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reveal := fs.Reveal([]byte("client-seed"))
+			tt.tamper(&reveal)
+			if err := poker.VerifyShuffle(commitment, reveal); !errors.Is(err, tt.want) {
+				t.Errorf("VerifyShuffle() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFairShuffle(t *testing.T) {
+	fs1, err := poker.NewFairShuffle()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	fs2, err := poker.NewFairShuffle()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fs1.Commitment() == fs2.Commitment() {
+		t.Error("two random server seeds produced the same commitment")
+	}
+	if err := poker.VerifyShuffle(fs1.Commitment(), fs1.Reveal(nil)); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := poker.NewFairShuffleWithSeed(nil); err == nil {
+		t.Error("expected error for empty server seed, got nil")
+	}
+}
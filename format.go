@@ -0,0 +1,127 @@
+package poker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiBlue  = "\x1b[34m"
+)
+
+// Symbol returns the Unicode glyph of the suit such as "♥".
+func (s Suit) Symbol() string {
+	switch s {
+	case Hearts:
+		return "♥"
+	case Clubs:
+		return "♣"
+	case Diamonds:
+		return "♦"
+	case Spades:
+		return "♠"
+	default:
+		return "?"
+	}
+}
+
+// StringUnicode returns the card with a Unicode suit glyph such as "A♥".
+func (c Card) StringUnicode() string {
+	return c.Rank.String() + c.Suit.Symbol()
+}
+
+// ColorString returns the card with a Unicode suit glyph colored with ANSI
+// escape codes. Red suits are colored red, or with fourColor hearts are red,
+// diamonds blue and clubs green.
+func (c Card) ColorString(fourColor bool) string {
+	s := c.StringUnicode()
+	if color := c.ansiColor(!fourColor, fourColor); color != "" {
+		s = color + s + ansiReset
+	}
+	return s
+}
+
+// card has the fields of Card without its methods, so that fmt falls back to
+// the default struct formatting.
+type card Card
+
+// Format implements fmt.Formatter.
+//
+//	%s  short notation: Ah
+//	%v  long notation: A hearts
+//	%u  Unicode suit: A♥
+//	%q  quoted short notation: "Ah"
+//
+// With %u the '+' flag colors red suits with ANSI escape codes and the '#'
+// flag uses a four-color deck as in ColorString.
+// %+v prints the long notation like %v, %#v prints Go syntax and the other
+// verbs print the default struct formatting.
+func (c Card) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 's':
+		fmt.Fprint(f, c.StringShort())
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "poker.Card{Rank:%d, Suit:%d}", c.Rank, c.Suit)
+	case verb == 'v':
+		fmt.Fprint(f, c.String())
+	case verb == 'u' && (f.Flag('+') || f.Flag('#')):
+		fmt.Fprint(f, c.ColorString(f.Flag('#')))
+	case verb == 'u':
+		fmt.Fprint(f, c.StringUnicode())
+	case verb == 'q':
+		fmt.Fprint(f, strconv.Quote(c.StringShort()))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), card(c))
+	}
+}
+
+func (c Card) ansiColor(twoColor, fourColor bool) string {
+	switch {
+	case fourColor:
+		switch c.Suit {
+		case Hearts:
+			return ansiRed
+		case Diamonds:
+			return ansiBlue
+		case Clubs:
+			return ansiGreen
+		}
+	case twoColor:
+		if c.Suit == Hearts || c.Suit == Diamonds {
+			return ansiRed
+		}
+	}
+	return ""
+}
+
+// Cards is a list of cards that prints as "[Ah Kd Qs]".
+type Cards []Card
+
+func (cs Cards) String() string {
+	return fmt.Sprintf("%s", cs)
+}
+
+// Format implements fmt.Formatter.
+// Every card is formatted with the same verb and flags as described in Card.Format,
+// except that %v prints the short notation like %s and %#v prints Go syntax.
+// %+v prints the long notation of every card.
+func (cs Cards) Format(f fmt.State, verb rune) {
+	sep, open, closing := " ", "[", "]"
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		sep, open, closing = ", ", "poker.Cards{", "}"
+	case verb == 'v' && !f.Flag('+'):
+		verb = 's'
+	}
+	format := fmt.FormatString(f, verb)
+
+	parts := make([]string, 0, len(cs))
+	for _, c := range cs {
+		parts = append(parts, fmt.Sprintf(format, c))
+	}
+	fmt.Fprint(f, open+strings.Join(parts, sep)+closing)
+}
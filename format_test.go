@@ -0,0 +1,80 @@
+package poker_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/whywaita/poker-go"
+)
+
+func TestCard_Format(t *testing.T) {
+	ah := poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}
+	ks := poker.Card{Rank: poker.RankKing, Suit: poker.Spades}
+	td := poker.Card{Rank: poker.RankTen, Suit: poker.Diamonds}
+	qc := poker.Card{Rank: poker.RankQueen, Suit: poker.Clubs}
+
+	tests := []struct {
+		name   string
+		format string
+		card   poker.Card
+		want   string
+	}{
+		{name: "short", format: "%s", card: ah, want: "Ah"},
+		{name: "long", format: "%v", card: ah, want: "A hearts"},
+		{name: "unicode", format: "%u", card: ks, want: "K♠"},
+		{name: "quoted", format: "%q", card: td, want: `"Td"`},
+		{name: "two-color red", format: "%+u", card: td, want: "\x1b[31mT♦\x1b[0m"},
+		{name: "two-color black", format: "%+u", card: qc, want: "Q♣"},
+		{name: "four-color diamonds", format: "%#u", card: td, want: "\x1b[34mT♦\x1b[0m"},
+		{name: "four-color clubs", format: "%#u", card: qc, want: "\x1b[32mQ♣\x1b[0m"},
+		{name: "four-color spades", format: "%#u", card: ks, want: "K♠"},
+		{name: "short ignores flags", format: "%+s", card: ah, want: "Ah"},
+		{name: "long with plus flag", format: "%+v", card: ah, want: "A hearts"},
+		{name: "go syntax", format: "%#v", card: ah, want: "poker.Card{Rank:13, Suit:0}"},
+		{name: "default verb", format: "%d", card: ah, want: "{13 0}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.card); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCards_Format(t *testing.T) {
+	cards := poker.Cards(poker.MustParseCards("Ah Kd Qs"))
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "%s", want: "[Ah Kd Qs]"},
+		{format: "%v", want: "[Ah Kd Qs]"},
+		{format: "%u", want: "[A♥ K♦ Q♠]"},
+		{format: "%+u", want: "[\x1b[31mA♥\x1b[0m \x1b[31mK♦\x1b[0m Q♠]"},
+		{format: "%#v", want: "poker.Cards{poker.Card{Rank:13, Suit:0}, poker.Card{Rank:12, Suit:2}, poker.Card{Rank:11, Suit:3}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, cards); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+
+	if got := fmt.Sprintf("%+v", cards[:1]); got != "[A hearts]" {
+		t.Errorf("Sprintf(%%+v) = %q, want %q", got, "[A hearts]")
+	}
+	if got := fmt.Sprintf("%+v", poker.DealtCard{Card: cards[0]}); got != "{Card:A hearts Target:seat Seat:0}" {
+		t.Errorf("Sprintf(%%+v) = %q, want %q", got, "{Card:A hearts Target:seat Seat:0}")
+	}
+	if got := cards.String(); got != "[Ah Kd Qs]" {
+		t.Errorf("String() = %q, want %q", got, "[Ah Kd Qs]")
+	}
+	if got := fmt.Sprint(poker.Cards(nil)); got != "[]" {
+		t.Errorf("Sprint(nil) = %q, want %q", got, "[]")
+	}
+}
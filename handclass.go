@@ -0,0 +1,112 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// NumHandClasses is the number of equivalence classes of five-card hands,
+// i.e. the number of distinct MadeHand values.
+const NumHandClasses = 7462
+
+// HandClass is one of the equivalence classes of five-card hands: all hands that tie
+// with each other, such as every ace-high straight.
+type HandClass struct {
+	// Value is the MadeHand.Value of the class, from 1 for a royal flush
+	// to NumHandClasses for 7-5-4-3-2.
+	Value int
+	Rank  HandRank
+	// Cards is an example hand of the class.
+	Cards []Card
+}
+
+// HandClasses returns every equivalence class ordered by Value, i.e. from the strongest to the weakest.
+func HandClasses() []HandClass {
+	classes := make([]HandClass, 0, NumHandClasses)
+	forEachHandClass(func(cards []Card) {
+		cards = slices.Clone(cards)
+		rank, _, _ := EvaluateRank(cards)
+		md, _ := NewMadeHandFromRank(rank)
+		classes = append(classes, HandClass{Value: md.Value, Rank: rank, Cards: cards})
+	})
+	slices.SortFunc(classes, func(a, b HandClass) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return classes
+}
+
+// NewMadeHandFromRank returns the made hand of the equivalence class of r.
+// It returns an error if no five-card hand has rank r.
+func NewMadeHandFromRank(r HandRank) (*MadeHand, error) {
+	madeHandRanksOnce.Do(buildMadeHandRanks)
+	// madeHandRanks is sorted from the strongest to the weakest.
+	i, found := slices.BinarySearchFunc(madeHandRanks[1:], r, func(e, target HandRank) int {
+		return target.Compare(e)
+	})
+	if !found {
+		return nil, fmt.Errorf("no hand has rank %s", r)
+	}
+	return NewMadeHandFromIndex(i + 1), nil
+}
+
+// BestFive returns the five cards of cards that make the hand, which should be the cards
+// the hand was made from. The cards are ordered as in the description of the hand:
+// the cards of the combination first, then the kickers from high to low,
+// and straights from their highest card, e.g. 5 4 3 2 A for a wheel.
+// It returns an error if cards cannot make the hand.
+func (hand *MadeHand) BestFive(cards []Card) ([]Card, error) {
+	rank := hand.Rank()
+	if rank == 0 {
+		return nil, fmt.Errorf("invalid made hand value: %d", hand.Value)
+	}
+
+	category := rank.Category()
+	k := rank.Kickers()
+	var need []Rank
+	switch category {
+	case HandTypeRoyalFlush, HandTypeStraightFlush, HandTypeStraight:
+		for i := range 5 {
+			r := k[0] - Rank(i)
+			if r < RankDeuce {
+				r = RankAce
+			}
+			need = append(need, r)
+		}
+	case HandTypeFourOfAKind:
+		need = []Rank{k[0], k[0], k[0], k[0], k[1]}
+	case HandTypeFullHouse:
+		need = []Rank{k[0], k[0], k[0], k[1], k[1]}
+	case HandTypeThreeOfAKind:
+		need = []Rank{k[0], k[0], k[0], k[1], k[2]}
+	case HandTypeTwoPair:
+		need = []Rank{k[0], k[0], k[1], k[1], k[2]}
+	case HandTypePair:
+		need = []Rank{k[0], k[0], k[1], k[2], k[3]}
+	default:
+		need = k
+	}
+
+	var flushSuit Suit
+	suited := category == HandTypeRoyalFlush || category == HandTypeStraightFlush || category == HandTypeFlush
+	if suited {
+		var ok bool
+		if flushSuit, ok = findFlushSuit(cards); !ok {
+			return nil, fmt.Errorf("cards %s do not make %s", Cards(cards), rank)
+		}
+	}
+
+	var used CardSet
+	best := make([]Card, 0, len(need))
+	for _, r := range need {
+		i := slices.IndexFunc(cards, func(c Card) bool {
+			return c.Rank == r && (!suited || c.Suit == flushSuit) && !used.Contains(c)
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("cards %s do not make %s", Cards(cards), rank)
+		}
+		used = used.Add(cards[i])
+		best = append(best, cards[i])
+	}
+	return best, nil
+}
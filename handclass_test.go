@@ -0,0 +1,179 @@
+package poker_test
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestHandClasses(t *testing.T) {
+	classes := poker.HandClasses()
+	if len(classes) != poker.NumHandClasses {
+		t.Fatalf("got %d classes, want %d", len(classes), poker.NumHandClasses)
+	}
+
+	counts := make(map[poker.HandType]int)
+	for i, c := range classes {
+		if c.Value != i+1 {
+			t.Fatalf("class %d has value %d", i, c.Value)
+		}
+		if i > 0 && !c.Rank.Less(classes[i-1].Rank) {
+			t.Errorf("class %d (%s) is not weaker than class %d (%s)", c.Value, c.Rank, c.Value-1, classes[i-1].Rank)
+		}
+		if got := poker.NewMadeHandFromIndex(c.Value).Rank(); got != c.Rank {
+			t.Errorf("value %d decodes to %s, want %s", c.Value, got, c.Rank)
+		}
+		rank, _, err := poker.EvaluateRank(c.Cards)
+		if err != nil || rank != c.Rank {
+			t.Errorf("example %s of %s evaluates to %s, %v", poker.Cards(c.Cards), c.Rank, rank, err)
+		}
+		counts[c.Rank.Category()]++
+	}
+
+	want := map[poker.HandType]int{
+		poker.HandTypeRoyalFlush:    1,
+		poker.HandTypeStraightFlush: 9,
+		poker.HandTypeFourOfAKind:   156,
+		poker.HandTypeFullHouse:     156,
+		poker.HandTypeFlush:         1277,
+		poker.HandTypeStraight:      10,
+		poker.HandTypeThreeOfAKind:  858,
+		poker.HandTypeTwoPair:       858,
+		poker.HandTypePair:          2860,
+		poker.HandTypeHighCard:      1277,
+	}
+	if diff := cmp.Diff(want, counts); diff != "" {
+		t.Errorf("classes per category mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewMadeHandFromRank(t *testing.T) {
+	md, err := poker.NewMadeHandFromRank(poker.NewHandRank(poker.HandTypeHighCard, poker.RankSeven, poker.RankFive, poker.RankFour, poker.RankThree, poker.RankDeuce))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if md.Value != poker.NumHandClasses {
+		t.Errorf("Value = %d, want %d", md.Value, poker.NumHandClasses)
+	}
+
+	// 6-5-4-3-2 is a straight, not a high card.
+	if _, err := poker.NewMadeHandFromRank(poker.NewHandRank(poker.HandTypeHighCard, poker.RankSix, poker.RankFive, poker.RankFour, poker.RankThree, poker.RankDeuce)); err == nil {
+		t.Error("expected error")
+	}
+	if got := poker.NewMadeHandFromIndex(poker.NumHandClasses + 1).Rank(); got != 0 {
+		t.Errorf("Rank() of an invalid value = %s, want 0", got)
+	}
+}
+
+// TestMadeHand_Tables checks every entry of the lookup tables that a 7-card hand can reach
+// against the rule-based evaluator.
+func TestMadeHand_Tables(t *testing.T) {
+	check := func(cards []poker.Card) {
+		t.Helper()
+		want, err := poker.RuleEvaluator.Evaluate(cards)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := poker.NewBestMadeHand(cards).Rank(); got != want {
+			t.Fatalf("cards %s: NewBestMadeHand() = %s, want %s", poker.Cards(cards), got, want)
+		}
+	}
+
+	// Every set of 5 to 7 ranks of a flush suit, completed with clubs.
+	var flushes int
+	for mask := range 1 << 13 {
+		if n := bits.OnesCount(uint(mask)); n < 5 || n > 7 {
+			continue
+		}
+		var cards []poker.Card
+		for i := range 13 {
+			if mask&(1<<i) != 0 {
+				cards = append(cards, poker.Card{Rank: poker.RankDeuce + poker.Rank(i), Suit: poker.Hearts})
+			}
+		}
+		for i := 0; len(cards) < 7; i++ {
+			cards = append(cards, poker.Card{Rank: cards[i].Rank, Suit: poker.Clubs})
+		}
+		check(cards)
+		flushes++
+	}
+
+	// Every multiset of 7 ranks. Equal ranks are adjacent, so giving the i-th card suit i%4
+	// never repeats a card and puts at most two cards in a suit.
+	var rainbows int
+	cards := make([]poker.Card, 7)
+	var rec func(i int, maxRank poker.Rank, same int)
+	rec = func(i int, maxRank poker.Rank, same int) {
+		if i == len(cards) {
+			check(cards)
+			rainbows++
+			return
+		}
+		for r := maxRank; r >= poker.RankDeuce; r-- {
+			n := 1
+			if i > 0 && cards[i-1].Rank == r {
+				n = same + 1
+			}
+			if n > 4 {
+				continue
+			}
+			cards[i] = poker.Card{Rank: r, Suit: poker.Suit(i % 4)}
+			rec(i+1, r, n)
+		}
+	}
+	rec(0, poker.RankAce, 0)
+
+	if flushes != 4719 || rainbows != 49205 {
+		t.Errorf("checked %d flushes and %d rainbows, want 4719 and 49205", flushes, rainbows)
+	}
+}
+
+func TestMadeHand_BestFive(t *testing.T) {
+	tests := []struct {
+		cards string
+		want  string
+	}{
+		{cards: "2c Th Ah 3d Kh Qh Jh", want: "Ah Kh Qh Jh Th"},
+		{cards: "As Kd Qc 5s 4s 3s 2s", want: "5s 4s 3s 2s As"},
+		{cards: "9s 2c 9h Kd 9d 3d 9c", want: "9s 9h 9d 9c Kd"},
+		{cards: "4c 9s 9h Ac 9d 4d 2d", want: "9s 9h 9d 4c 4d"},
+		{cards: "Kh 9h Ac 6h 3h 2h 2d", want: "Kh 9h 6h 3h 2h"},
+		{cards: "Ac 5c Kd 4d 3h 2s 9c", want: "5c 4d 3h 2s Ac"},
+		{cards: "2c 7s Kd 7h 7d Ac 3d", want: "7s 7h 7d Ac Kd"},
+		{cards: "4c 7h Ks 2d 7c Kd 3s", want: "Ks Kd 7h 7c 4c"},
+		{cards: "Ks 4c 9h 7c 2d 3s Kd", want: "Ks Kd 9h 7c 4c"},
+		{cards: "As Jd 9h 7c 2d 3s 4c", want: "As Jd 9h 7c 4c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cards, func(t *testing.T) {
+			cards := poker.MustParseCards(tt.cards)
+			got, err := poker.NewBestMadeHand(cards).BestFive(cards)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.want), got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := poker.NewMadeHandFromIndex(1).BestFive(poker.MustParseCards("Ah Kh Qh Jh 9h")); err == nil {
+		t.Error("expected error for cards that do not make the hand")
+	}
+
+	invalid := []poker.Card{poker.RedJoker, {Rank: poker.RankAce, Suit: poker.Suit(9)}, {Rank: poker.RankKing, Suit: poker.Hearts},
+		{Rank: poker.RankQueen, Suit: poker.Hearts}, {Rank: poker.RankJack, Suit: poker.Hearts}, {Rank: poker.RankTen, Suit: poker.Hearts}}
+	ah := poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}
+	for _, cards := range [][]poker.Card{invalid, {{Rank: poker.RankUnknown}, ah, ah, ah, ah}, {ah, ah, ah, ah, ah}} {
+		md := poker.NewBestMadeHand(cards)
+		if md.Value != 0 || md.Rank() != 0 || md.Type() != poker.HandTypeUnknown || md.Power() != 0 {
+			t.Errorf("NewBestMadeHand(%s) = %d %s %d, want 0 %s 0", poker.Cards(cards), md.Value, md.Type(), md.Power(), poker.HandTypeUnknown)
+		}
+	}
+	if _, err := poker.NewMadeHandFromIndex(1).BestFive(invalid); err == nil {
+		t.Error("expected error for invalid cards")
+	}
+}
@@ -0,0 +1,178 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// HandRank is the strength of a five-card hand. It encodes the HandType and the
+// ranks that break ties within it, so two HandRank values order totally and can
+// be compared with the usual operators: a greater HandRank is a stronger hand.
+type HandRank uint32
+
+const (
+	handRankCategoryShift = 20
+	handRankKickerBits    = 4
+	handRankMaxKickers    = 5
+)
+
+// NewHandRank returns the HandRank of category with the given tie-breaking ranks
+// in priority order, e.g. the pair rank followed by the three kickers.
+func NewHandRank(category HandType, ranks ...Rank) HandRank {
+	r := HandRank(category) << handRankCategoryShift
+	for i, rank := range ranks[:min(len(ranks), handRankMaxKickers)] {
+		shift := handRankKickerBits * (handRankMaxKickers - 1 - i)
+		r |= HandRank(rank) << shift
+	}
+	return r
+}
+
+// Category returns the HandType of the hand.
+func (r HandRank) Category() HandType {
+	return HandType(r >> handRankCategoryShift)
+}
+
+// Kickers returns the ranks that break ties within the category in priority order,
+// e.g. [K 7 2] for kings and sevens with a deuce, or [5] for a five-high straight.
+func (r HandRank) Kickers() []Rank {
+	var ranks []Rank
+	for i := range handRankMaxKickers {
+		shift := handRankKickerBits * (handRankMaxKickers - 1 - i)
+		rank := Rank(r>>shift) & (1<<handRankKickerBits - 1)
+		if rank == RankUnknown {
+			break
+		}
+		ranks = append(ranks, rank)
+	}
+	return ranks
+}
+
+// Compare returns 1 if r is stronger than o, -1 if r is weaker, and 0 if they tie.
+func (r HandRank) Compare(o HandRank) int {
+	return cmp.Compare(r, o)
+}
+
+// Less reports whether r is weaker than o.
+func (r HandRank) Less(o HandRank) bool {
+	return r < o
+}
+
+func (r HandRank) String() string {
+	kickers := make([]string, 0, handRankMaxKickers)
+	for _, k := range r.Kickers() {
+		kickers = append(kickers, k.String())
+	}
+	return fmt.Sprintf("%s [%s]", r.Category(), strings.Join(kickers, " "))
+}
+
+// EvaluateRank is like Evaluate but returns the HandRank of the best five cards.
+// Unlike Evaluate, it does not reorder cards.
+func EvaluateRank(cards []Card) (HandRank, []Card, error) {
+	handType, hand, err := Evaluate(slices.Clone(cards))
+	if err != nil {
+		return 0, nil, err
+	}
+	return NewHandRankFromHand(handType, hand), hand, nil
+}
+
+// Rank returns the HandRank of the made hand, which decodes its Value into the category
+// and the ranks that break ties. It returns 0 if Value is not between 1 and NumHandClasses.
+func (hand *MadeHand) Rank() HandRank {
+	if hand.Value < 1 || hand.Value > NumHandClasses {
+		return 0
+	}
+	madeHandRanksOnce.Do(buildMadeHandRanks)
+	return madeHandRanks[hand.Value]
+}
+
+// PlayerRank is a player with the rank of their best hand.
+type PlayerRank struct {
+	Player Player
+	Rank   HandRank
+}
+
+// RankPlayers returns every player with the rank of their best hand on board,
+// strongest first. Players with equal ranks keep their input order.
+// Hands are ranked by RuleEvaluator unless WithEvaluator is given.
+func RankPlayers(players []Player, board []Card, opts ...Option) ([]PlayerRank, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, board); err != nil {
+		return nil, err
+	}
+	e := o.evaluatorOr(RuleEvaluator)
+	ranked := make([]PlayerRank, 0, len(players))
+	for _, p := range players {
+		rank, err := e.Evaluate(slices.Concat(p.Hand, board))
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate %s's hand: %w", p.Name, err)
+		}
+		ranked = append(ranked, PlayerRank{Player: p, Rank: rank})
+	}
+	slices.SortStableFunc(ranked, func(a, b PlayerRank) int {
+		return b.Rank.Compare(a.Rank)
+	})
+	return ranked, nil
+}
+
+// NewHandRankFromHand returns the HandRank of the hand type and the best five cards returned by Evaluate.
+func NewHandRankFromHand(handType HandType, hand []Card) HandRank {
+	return NewHandRank(handType, handRankKey(handType, hand)...)
+}
+
+var (
+	madeHandRanksOnce sync.Once
+	// madeHandRanks maps MadeHand.Value to HandRank. Index 0 is unused.
+	madeHandRanks [NumHandClasses + 1]HandRank
+)
+
+// buildMadeHandRanks fills madeHandRanks. The lookup tables number the
+// equivalence classes from the strongest (1) to the weakest (7462), so sorting
+// the HandRank of every class from strongest to weakest yields the same numbering.
+func buildMadeHandRanks() {
+	ranks := make([]HandRank, 0, len(madeHandRanks)-1)
+	forEachHandClass(func(cards []Card) {
+		handType, hand, _ := evaluate(slices.Clone(cards))
+		ranks = append(ranks, NewHandRankFromHand(handType, hand))
+	})
+	slices.SortFunc(ranks, func(a, b HandRank) int {
+		return b.Compare(a)
+	})
+	copy(madeHandRanks[1:], ranks)
+}
+
+// forEachHandClass calls fn with one five-card hand of every one of the 7462
+// equivalence classes: 6175 rank combinations without a flush and 1287 flushes.
+func forEachHandClass(fn func(cards []Card)) {
+	cards := make([]Card, 5)
+	var rec func(i int, maxRank Rank, flush bool)
+	rec = func(i int, maxRank Rank, flush bool) {
+		if i == len(cards) {
+			fn(cards)
+			return
+		}
+		for r := maxRank; r >= RankDeuce; r-- {
+			same := 0
+			for _, c := range cards[:i] {
+				if c.Rank == r {
+					same++
+				}
+			}
+			if (flush && same > 0) || same == 4 {
+				continue
+			}
+			// Equal ranks are adjacent, so i%4 gives them distinct suits
+			// and five cards never share a single suit.
+			suit := Suit(i % 4)
+			if flush {
+				suit = Hearts
+			}
+			cards[i] = Card{Rank: r, Suit: suit}
+			rec(i+1, r, flush)
+		}
+	}
+	rec(0, RankAce, false)
+	rec(0, RankAce, true)
+}
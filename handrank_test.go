@@ -0,0 +1,150 @@
+package poker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHandRank(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       string
+		wantType    HandType
+		wantKickers []Rank
+	}{
+		{name: "royal flush", cards: "Ah Kh Qh Jh Th 2c 3d", wantType: HandTypeRoyalFlush, wantKickers: []Rank{RankAce}},
+		{name: "steel wheel", cards: "5s 4s 3s 2s As Kd Qc", wantType: HandTypeStraightFlush, wantKickers: []Rank{RankFive}},
+		{name: "four of a kind", cards: "9s 9h 9d 9c Kd 2c 3d", wantType: HandTypeFourOfAKind, wantKickers: []Rank{RankNine, RankKing}},
+		{name: "full house", cards: "9s 9h 9d 4c 4d Ac 2d", wantType: HandTypeFullHouse, wantKickers: []Rank{RankNine, RankFour}},
+		{name: "flush", cards: "Kh 9h 6h 3h 2h Ac 2d", wantType: HandTypeFlush, wantKickers: []Rank{RankKing, RankNine, RankSix, RankThree, RankDeuce}},
+		{name: "wheel", cards: "5c 4d 3h 2s Ac Kd 9c", wantType: HandTypeStraight, wantKickers: []Rank{RankFive}},
+		{name: "three of a kind", cards: "7s 7h 7d Ac Kd 2c 3d", wantType: HandTypeThreeOfAKind, wantKickers: []Rank{RankSeven, RankAce, RankKing}},
+		{name: "two pair", cards: "Ks Kd 7h 7c 2d 3s 4c", wantType: HandTypeTwoPair, wantKickers: []Rank{RankKing, RankSeven, RankFour}},
+		{name: "pair", cards: "Ks Kd 9h 7c 2d 3s 4c", wantType: HandTypePair, wantKickers: []Rank{RankKing, RankNine, RankSeven, RankFour}},
+		{name: "high card", cards: "As Jd 9h 7c 2d 3s 4c", wantType: HandTypeHighCard, wantKickers: []Rank{RankAce, RankJack, RankNine, RankSeven, RankFour}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards := MustParseCards(tt.cards)
+			got, _, err := EvaluateRank(cards)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.Category() != tt.wantType {
+				t.Errorf("Category() = %s, want %s", got.Category(), tt.wantType)
+			}
+			if diff := cmp.Diff(tt.wantKickers, got.Kickers()); diff != "" {
+				t.Errorf("Kickers() mismatch (-want +got):\n%s", diff)
+			}
+			if want := NewHandRank(tt.wantType, tt.wantKickers...); got != want {
+				t.Errorf("EvaluateRank() = %s, want %s", got, want)
+			}
+			if md := NewBestMadeHand(cards).Rank(); md != got {
+				t.Errorf("MadeHand.Rank() = %s, want %s", md, got)
+			}
+		})
+	}
+}
+
+func TestHandRank_Order(t *testing.T) {
+	ranks := []HandRank{
+		NewHandRank(HandTypeHighCard, RankAce, RankKing, RankQueen, RankJack, RankNine),
+		NewHandRank(HandTypePair, RankKing, RankQueen, RankFour, RankThree),
+		NewHandRank(HandTypePair, RankKing, RankAce, RankFour, RankThree),
+		NewHandRank(HandTypeStraight, RankFive),
+		NewHandRank(HandTypeStraight, RankSix),
+		NewHandRank(HandTypeFlush, RankAce, RankKing, RankQueen, RankJack, RankNine),
+		NewHandRank(HandTypeFullHouse, RankDeuce, RankThree),
+		NewHandRank(HandTypeRoyalFlush, RankAce),
+	}
+	for i := 1; i < len(ranks); i++ {
+		if !ranks[i-1].Less(ranks[i]) || ranks[i].Compare(ranks[i-1]) != 1 || ranks[i-1].Compare(ranks[i]) != -1 {
+			t.Errorf("expected %s < %s", ranks[i-1], ranks[i])
+		}
+	}
+	if ranks[3].Compare(NewHandRank(HandTypeStraight, RankFive)) != 0 {
+		t.Error("expected equal ranks to compare as 0")
+	}
+	if got := ranks[2].String(); got != "Pair [K A 4 3]" {
+		t.Errorf("String() = %q, want %q", got, "Pair [K A 4 3]")
+	}
+}
+
+// TestMadeHandRank_AllClasses checks that every equivalence class has a distinct
+// HandRank and that HandRank agrees with the lookup table categories.
+func TestMadeHandRank_AllClasses(t *testing.T) {
+	seen := make(map[HandRank]bool)
+	forEachHandClass(func(cards []Card) {
+		rank, _, err := EvaluateRank(cards)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		seen[rank] = true
+	})
+	if len(seen) != 7462 {
+		t.Fatalf("got %d distinct classes, want 7462", len(seen))
+	}
+
+	for v := 1; v <= 7462; v++ {
+		md := NewMadeHandFromIndex(v)
+		if md.Rank().Category() != md.Type() {
+			t.Errorf("value %d: Category() = %s, Type() = %s", v, md.Rank().Category(), md.Type())
+		}
+		if v > 1 && !md.Rank().Less(NewMadeHandFromIndex(v-1).Rank()) {
+			t.Errorf("value %d (%s) should be weaker than value %d", v, md.Rank(), v-1)
+		}
+	}
+}
+
+// TestMadeHandRank_MatchesEvaluate checks both evaluators on 7-card hands drawn
+// from every board of a fixed pair of hole cards.
+func TestMadeHandRank_MatchesEvaluate(t *testing.T) {
+	hole := MustParseCards("Ah 7d")
+	deck := NewDeck()
+	deck.RemoveCardSet(NewCardSet(hole...))
+
+	for i, board := range AllCombinations(deck.Cards, 5) {
+		if i%97 != 0 {
+			continue
+		}
+		cards := append(slices.Clone(hole), board...)
+		want, _, err := EvaluateRank(cards)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := NewBestMadeHand(cards).Rank(); got != want {
+			t.Fatalf("cards %v: MadeHand.Rank() = %s, EvaluateRank() = %s", Cards(cards), got, want)
+		}
+	}
+}
+
+func TestRankPlayers(t *testing.T) {
+	players := []Player{
+		{Name: "alice", Hand: MustParseCards("Kh Qh")},
+		{Name: "bob", Hand: MustParseCards("As 3c")},
+		{Name: "carol", Hand: MustParseCards("Ad 4d")},
+		{Name: "dave", Hand: MustParseCards("7s 7c")},
+	}
+	board := MustParseCards("Ac 9d 7h 2s 5c")
+
+	got, err := RankPlayers(players, board)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	names := make([]string, 0, len(got))
+	for _, pr := range got {
+		names = append(names, pr.Player.Name)
+	}
+	if diff := cmp.Diff([]string{"dave", "bob", "carol", "alice"}, names); diff != "" {
+		t.Errorf("order mismatch (-want +got):\n%s", diff)
+	}
+	if got[1].Rank != got[2].Rank {
+		t.Errorf("expected bob and carol to tie, got %s and %s", got[1].Rank, got[2].Rank)
+	}
+	if !slices.Equal(players[0].Hand, MustParseCards("Kh Qh")) {
+		t.Error("RankPlayers modified the player's hand")
+	}
+}
@@ -0,0 +1,191 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// Qualifies reports whether the hand is a low of limit or better, i.e. it has no pair
+// and no card above limit. Hi-lo split games play eight or better: Qualifies(RankEight).
+func (r LowRank) Qualifies(limit Rank) bool {
+	ranks := r.Ranks()
+	return r.Category() == HandTypeHighCard && len(ranks) > 0 && aceLowValue(ranks[0]) <= aceLowValue(limit)
+}
+
+// EightOrBetterEvaluator ranks the ace-to-five low of hands like AceToFiveEvaluator,
+// but returns 0 for hands without a low of eight or better.
+var EightOrBetterEvaluator Evaluator = EvaluatorFunc(func(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateAceToFive(cards)
+	if err != nil || !rank.Qualifies(RankEight) {
+		return 0, err
+	}
+	return HandRank(rank), nil
+})
+
+// WithLowEvaluator ranks the low half of hi-lo games with e instead of EightOrBetterEvaluator.
+// e must return 0 for hands that do not qualify for the low.
+func WithLowEvaluator(e Evaluator) Option {
+	return func(o *options) {
+		o.lowEvaluator = e
+	}
+}
+
+// HiLoResult is the outcome of a hi-lo split pot.
+type HiLoResult struct {
+	// High are the winners of the high half, in the order of the players.
+	High []Player
+	// Low are the winners of the low half, in the order of the players,
+	// or nil if no player has a qualifying low and the high hand takes the whole pot.
+	Low []Player
+	// Shares is the share of the pot won by each player, in the order of the players.
+	// A player who wins both halves alone scoops 1, and ties split a half further,
+	// e.g. 0.25 for a player who is quartered in the low half.
+	Shares []float64
+}
+
+// HasLow reports whether any player has a qualifying low.
+func (r *HiLoResult) HasLow() bool {
+	return len(r.Low) > 0
+}
+
+// CompareHandsHiLo returns the winners of the high and the low half of a hi-lo split pot,
+// such as Hold'em or Stud eight or better. The high is ranked by LookupEvaluator unless
+// WithEvaluator is given, and the low by EightOrBetterEvaluator unless WithLowEvaluator is given.
+func CompareHandsHiLo(players []Player, board []Card, opts ...Option) (*HiLoResult, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, board); err != nil {
+		return nil, err
+	}
+	high, low, err := o.hiLoWinners(players, board)
+	if err != nil {
+		return nil, err
+	}
+
+	highShares, lowShares := hiLoShares(len(players), high, low)
+	result := &HiLoResult{Shares: make([]float64, len(players))}
+	for i := range players {
+		result.Shares[i] = highShares[i] + lowShares[i]
+	}
+	for _, i := range high {
+		result.High = append(result.High, players[i])
+	}
+	for _, i := range low {
+		result.Low = append(result.Low, players[i])
+	}
+	return result, nil
+}
+
+// HiLoEquity is the equity of a player in a hi-lo split pot, split by how the pot is won.
+// Each field is the average share of the pot over every board.
+type HiLoEquity struct {
+	// Scoop is the share won by taking the whole pot alone.
+	Scoop float64
+	// High is the share won from the high half when the pot is not scooped.
+	High float64
+	// Low is the share won from the low half when the pot is not scooped.
+	Low float64
+}
+
+// Total returns the total share of the pot.
+func (e HiLoEquity) Total() float64 {
+	return e.Scoop + e.High + e.Low
+}
+
+// EvaluateEquityHiLo returns the equity of each player in a hi-lo split pot
+// given the community cards that are already dealt. Hands are ranked as in CompareHandsHiLo.
+func EvaluateEquityHiLo(players []Player, community []Card, opts ...Option) ([]HiLoEquity, error) {
+	if len(community) > 5 {
+		return nil, fmt.Errorf("community must be at most 5 cards, got %d", len(community))
+	}
+	o := newOptions(opts)
+	if err := o.validateDeal(players, community); err != nil {
+		return nil, err
+	}
+	deck, err := o.remainingDeck(append(playersCards(players), community...))
+	if err != nil {
+		return nil, err
+	}
+
+	equities := make([]HiLoEquity, len(players))
+	boards := AllCombinations(deck.Cards, 5-len(community))
+	full := make([]Card, 0, 5)
+	for _, board := range boards {
+		full = append(append(full[:0], community...), board...)
+		high, low, err := o.hiLoWinners(players, full)
+		if err != nil {
+			return nil, err
+		}
+		highShares, lowShares := hiLoShares(len(players), high, low)
+		for i := range equities {
+			if highShares[i]+lowShares[i] == 1 {
+				equities[i].Scoop++
+				continue
+			}
+			equities[i].High += highShares[i]
+			equities[i].Low += lowShares[i]
+		}
+	}
+
+	for i := range equities {
+		equities[i].Scoop /= float64(len(boards))
+		equities[i].High /= float64(len(boards))
+		equities[i].Low /= float64(len(boards))
+	}
+	return equities, nil
+}
+
+// hiLoWinners returns the indexes of the players who win the high and the low half.
+// low is nil if no player qualifies for the low.
+func (o *options) hiLoWinners(players []Player, board []Card) (high, low []int, err error) {
+	highEval := o.evaluatorOr(LookupEvaluator)
+	lowEval := o.lowEvaluator
+	if lowEval == nil {
+		lowEval = EightOrBetterEvaluator
+	}
+
+	var bestHigh, bestLow HandRank
+	cards := make([]Card, 0, 7)
+	for i, p := range players {
+		cards = append(append(cards[:0], p.Hand...), board...)
+		h, err := highEval.Evaluate(cards)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate %s's high hand: %w", p.Name, err)
+		}
+		l, err := lowEval.Evaluate(cards)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate %s's low hand: %w", p.Name, err)
+		}
+
+		switch {
+		case i == 0 || h > bestHigh:
+			bestHigh, high = h, []int{i}
+		case h == bestHigh:
+			high = append(high, i)
+		}
+		switch {
+		case l == 0:
+		case l > bestLow:
+			bestLow, low = l, []int{i}
+		case l == bestLow:
+			low = append(low, i)
+		}
+	}
+	return high, low, nil
+}
+
+// hiLoShares returns the share of the pot that each of n players wins from the high half
+// and from the low half. The high hands take the whole pot if no player has a low.
+func hiLoShares(n int, high, low []int) (highShares, lowShares []float64) {
+	highShares = make([]float64, n)
+	lowShares = make([]float64, n)
+	highHalf := 1.0
+	if len(low) > 0 {
+		highHalf = 0.5
+	}
+	for _, i := range high {
+		highShares[i] = highHalf / float64(len(high))
+	}
+	for _, i := range low {
+		lowShares[i] = (1 - highHalf) / float64(len(low))
+	}
+	return highShares, lowShares
+}
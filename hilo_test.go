@@ -0,0 +1,151 @@
+package poker_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+func TestLowRank_Qualifies(t *testing.T) {
+	tests := []struct {
+		cards string
+		want  bool
+	}{
+		{cards: "8h 7d 4c 3s 2h", want: true},
+		{cards: "5h 4d 3c 2s Ah", want: true},
+		{cards: "9h 4d 3c 2s Ah", want: false},
+		{cards: "8h 8d 3c 2s Ah", want: false},
+	}
+	for _, tt := range tests {
+		rank, _, err := poker.EvaluateAceToFive(poker.MustParseCards(tt.cards))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := rank.Qualifies(poker.RankEight); got != tt.want {
+			t.Errorf("%s: Qualifies(8) = %v, want %v", tt.cards, got, tt.want)
+		}
+	}
+}
+
+func TestCompareHandsHiLo(t *testing.T) {
+	tests := []struct {
+		name       string
+		hands      []string
+		board      string
+		wantHigh   []string
+		wantLow    []string
+		wantShares []float64
+	}{
+		{
+			name:       "no qualifying low",
+			hands:      []string{"Ah Ad", "Kh Kd"},
+			board:      "Ac Kc Qs 9d 9h",
+			wantHigh:   []string{"p0"},
+			wantShares: []float64{1, 0},
+		},
+		{
+			name:       "split",
+			hands:      []string{"Kh Kd", "Ah 2d"},
+			board:      "Kc 7c 5s 4d 9h",
+			wantHigh:   []string{"p0"},
+			wantLow:    []string{"p1"},
+			wantShares: []float64{0.5, 0.5},
+		},
+		{
+			name:       "scoop with the wheel",
+			hands:      []string{"Ah 2d", "Kh Kd"},
+			board:      "3c 4c 5s Jd 9h",
+			wantHigh:   []string{"p0"},
+			wantLow:    []string{"p0"},
+			wantShares: []float64{1, 0},
+		},
+		{
+			name:       "quartered",
+			hands:      []string{"Ah 2d", "As 2c", "Kh Kd"},
+			board:      "3c 4c 8s Kc 9h",
+			wantHigh:   []string{"p2"},
+			wantLow:    []string{"p0", "p1"},
+			wantShares: []float64{0.25, 0.25, 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := hiLoPlayers(tt.hands)
+			got, err := poker.CompareHandsHiLo(players, poker.MustParseCards(tt.board))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.wantHigh, playerNames(got.High), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("High mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantLow, playerNames(got.Low), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Low mismatch (-want +got):\n%s", diff)
+			}
+			if got.HasLow() != (len(tt.wantLow) > 0) {
+				t.Errorf("HasLow() = %v", got.HasLow())
+			}
+			if diff := cmp.Diff(tt.wantShares, got.Shares); diff != "" {
+				t.Errorf("Shares mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEvaluateEquityHiLo(t *testing.T) {
+	players := hiLoPlayers([]string{"Ah 2d", "Kh Kd"})
+
+	// On the river the result is known: the wheel scoops.
+	got, err := poker.EvaluateEquityHiLo(players, poker.MustParseCards("3c 4c 5s Jd 9h"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]poker.HiLoEquity{{Scoop: 1}, {}}, got); diff != "" {
+		t.Errorf("river mismatch (-want +got):\n%s", diff)
+	}
+
+	// On the turn, any of the 9 clubs gives the high half to the king-high flush
+	// and the 35 other cards scoop for the wheel.
+	players = hiLoPlayers([]string{"Ah 2d", "Kc Kd"})
+	community := append(make([]poker.Card, 0, 5), poker.MustParseCards("3c 4c 5s Jc")...)
+	got, err = poker.EvaluateEquityHiLo(players, community)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if spare := community[:5][4]; spare != (poker.Card{}) {
+		t.Errorf("community was written past its length: %s", spare)
+	}
+	want := []poker.HiLoEquity{
+		{Scoop: 35.0 / 44, Low: 4.5 / 44},
+		{High: 4.5 / 44},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("turn mismatch (-want +got):\n%s", diff)
+	}
+	var total float64
+	for _, e := range got {
+		total += e.Total()
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("total equity = %v, want 1", total)
+	}
+}
+
+func hiLoPlayers(hands []string) []poker.Player {
+	players := make([]poker.Player, 0, len(hands))
+	for i, h := range hands {
+		players = append(players, poker.Player{Name: "p" + string(rune('0'+i)), Hand: poker.MustParseCards(h)})
+	}
+	return players
+}
+
+func playerNames(players []poker.Player) []string {
+	names := make([]string, 0, len(players))
+	for _, p := range players {
+		names = append(names, p.Name)
+	}
+	return names
+}
@@ -0,0 +1,323 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LowRank is the strength of an ace-to-five low hand, as played in Razz, California lowball
+// and the low half of split games. Aces are low and straights and flushes do not count,
+// so the best hand is 5-4-3-2-A. A greater LowRank is a better, i.e. lower, hand.
+type LowRank uint32
+
+// lowRankMax is the badness of the zero LowRank, which is worse than any hand.
+const lowRankMax = 1<<24 - 1
+
+// NewLowRank returns the LowRank of category, which is HandTypeHighCard for a hand without
+// a pair, with the given ranks from the most significant, e.g. the pair rank and then the
+// other ranks from high to low.
+func NewLowRank(category HandType, ranks ...Rank) LowRank {
+	badness := uint32(category) << handRankCategoryShift
+	for i, rank := range ranks[:min(len(ranks), handRankMaxKickers)] {
+		shift := handRankKickerBits * (handRankMaxKickers - 1 - i)
+		badness |= uint32(aceLowValue(rank)) << shift
+	}
+	return LowRank(lowRankMax - badness)
+}
+
+// aceLowValue returns the value of r with aces below deuces, from 1 for an ace to 13 for a king.
+func aceLowValue(r Rank) int {
+	if r == RankAce {
+		return 1
+	}
+	return int(r) + 1
+}
+
+// Category returns HandTypeHighCard for a hand without a pair, or the paired HandType.
+func (r LowRank) Category() HandType {
+	return HandType((lowRankMax - uint32(r)) >> handRankCategoryShift)
+}
+
+// Ranks returns the ranks of the hand from the most significant, e.g. [7 5 4 3 A].
+func (r LowRank) Ranks() []Rank {
+	badness := lowRankMax - uint32(r)
+	var ranks []Rank
+	for i := range handRankMaxKickers {
+		shift := handRankKickerBits * (handRankMaxKickers - 1 - i)
+		v := int(badness>>shift) & (1<<handRankKickerBits - 1)
+		switch v {
+		case 0:
+			return ranks
+		case 1:
+			ranks = append(ranks, RankAce)
+		default:
+			ranks = append(ranks, Rank(v-1))
+		}
+	}
+	return ranks
+}
+
+// Compare returns 1 if r is better than o, -1 if r is worse, and 0 if they tie.
+func (r LowRank) Compare(o LowRank) int {
+	return cmp.Compare(r, o)
+}
+
+// Less reports whether r is worse than o.
+func (r LowRank) Less(o LowRank) bool {
+	return r < o
+}
+
+func (r LowRank) String() string {
+	return r.Describe()
+}
+
+// Describe returns the hand in words with every rank, e.g. "7-5-4-3-A low"
+// or "Pair, Fours with Eight-Six-Five kickers".
+func (r LowRank) Describe() string {
+	if r.Category() != HandTypeHighCard {
+		return NewHandRank(r.Category(), r.Ranks()...).Describe()
+	}
+	return joinRanks(r.Ranks()) + " low"
+}
+
+// DescribeShort returns the hand in words by its two highest cards, e.g. "7-5 low" or "Pair, Fours".
+func (r LowRank) DescribeShort() string {
+	if r.Category() != HandTypeHighCard {
+		return NewHandRank(r.Category(), r.Ranks()...).DescribeShort()
+	}
+	ranks := r.Ranks()
+	return joinRanks(ranks[:min(2, len(ranks))]) + " low"
+}
+
+func joinRanks(ranks []Rank) string {
+	s := make([]string, 0, len(ranks))
+	for _, rank := range ranks {
+		s = append(s, rank.String())
+	}
+	return strings.Join(s, "-")
+}
+
+// EvaluateAceToFive returns the best ace-to-five low and its five cards for 5 to 7 cards.
+// The cards are ordered like the ranks of the LowRank.
+func EvaluateAceToFive(cards []Card) (LowRank, []Card, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be 5 to 7, got %d", len(cards))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
+	}
+
+	var best LowRank
+	var bestFive [5]Card
+	forEachFive(cards, func(five [5]Card) {
+		if rank := aceToFiveRank(&five); rank > best {
+			best, bestFive = rank, five
+		}
+	})
+	return best, bestFive[:], nil
+}
+
+// AceToFiveEvaluator ranks ace-to-five lows like EvaluateAceToFive, as LowRank values.
+var AceToFiveEvaluator Evaluator = EvaluatorFunc(func(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateAceToFive(cards)
+	return HandRank(rank), err
+})
+
+// aceToFiveRank returns the LowRank of five and sorts five like its ranks.
+func aceToFiveRank(five *[5]Card) LowRank {
+	var counts [RankAce + 1]int
+	for _, c := range five {
+		counts[c.Rank]++
+	}
+	slices.SortFunc(five[:], func(a, b Card) int {
+		return cmp.Or(
+			cmp.Compare(counts[b.Rank], counts[a.Rank]),
+			cmp.Compare(aceLowValue(b.Rank), aceLowValue(a.Rank)),
+		)
+	})
+
+	ranks := make([]Rank, 0, len(five))
+	for i, c := range five {
+		if i == 0 || c.Rank != five[i-1].Rank {
+			ranks = append(ranks, c.Rank)
+		}
+	}
+	return NewLowRank(pairedHandType(counts[five[0].Rank], len(ranks)), ranks...)
+}
+
+// pairedHandType returns the HandType of five cards ignoring straights and flushes,
+// given the count of the most frequent rank and the number of distinct ranks.
+func pairedHandType(most, distinct int) HandType {
+	switch {
+	case most == 4:
+		return HandTypeFourOfAKind
+	case most == 3 && distinct == 2:
+		return HandTypeFullHouse
+	case most == 3:
+		return HandTypeThreeOfAKind
+	case most == 2 && distinct == 3:
+		return HandTypeTwoPair
+	case most == 2:
+		return HandTypePair
+	default:
+		return HandTypeHighCard
+	}
+}
+
+// forEachFive calls fn with every combination of five of cards.
+func forEachFive(cards []Card, fn func(five [5]Card)) {
+	n := len(cards)
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			for c := b + 1; c < n; c++ {
+				for d := c + 1; d < n; d++ {
+					for e := d + 1; e < n; e++ {
+						fn([5]Card{cards[a], cards[b], cards[c], cards[d], cards[e]})
+					}
+				}
+			}
+		}
+	}
+}
+
+// DeuceToSevenRank is the strength of a deuce-to-seven low hand, as played in 2-7 single
+// and triple draw. Aces are high and straights and flushes count against the hand,
+// so the best hand is 7-5-4-3-2 and A-5-4-3-2 is merely ace high.
+// A greater DeuceToSevenRank is a better, i.e. lower, hand.
+type DeuceToSevenRank uint32
+
+// NewDeuceToSevenRank returns the DeuceToSevenRank of the hand whose high HandRank is r.
+func NewDeuceToSevenRank(r HandRank) DeuceToSevenRank {
+	return DeuceToSevenRank(lowRankMax - uint32(r))
+}
+
+// HighRank returns the rank of the hand in high poker, except that A-5-4-3-2 is not a straight.
+func (r DeuceToSevenRank) HighRank() HandRank {
+	return HandRank(lowRankMax - uint32(r))
+}
+
+// Category returns the HandType of the hand.
+func (r DeuceToSevenRank) Category() HandType {
+	return r.HighRank().Category()
+}
+
+// Ranks returns the ranks of the hand from the most significant, e.g. [7 5 4 3 2].
+func (r DeuceToSevenRank) Ranks() []Rank {
+	return r.HighRank().Kickers()
+}
+
+// Compare returns 1 if r is better than o, -1 if r is worse, and 0 if they tie.
+func (r DeuceToSevenRank) Compare(o DeuceToSevenRank) int {
+	return cmp.Compare(r, o)
+}
+
+// Less reports whether r is worse than o.
+func (r DeuceToSevenRank) Less(o DeuceToSevenRank) bool {
+	return r < o
+}
+
+func (r DeuceToSevenRank) String() string {
+	return r.Describe()
+}
+
+// deuceToSevenNumbers are the four seven-low hands, from number one to number four.
+var deuceToSevenNumbers = [...]string{"7-5-4-3-2", "7-6-4-3-2", "7-6-5-3-2", "7-6-5-4-2"}
+
+// Describe returns the hand in words with every rank, e.g. "number one: 7-5-4-3-2",
+// "8-6-4-3-2 low" or "Straight, Six high".
+func (r DeuceToSevenRank) Describe() string {
+	if r.Category() != HandTypeHighCard {
+		return r.HighRank().Describe()
+	}
+	s := joinRanks(r.Ranks())
+	if i := slices.Index(deuceToSevenNumbers[:], s); i >= 0 {
+		return fmt.Sprintf("number %s: %s", []string{"one", "two", "three", "four"}[i], s)
+	}
+	return s + " low"
+}
+
+// DescribeShort returns the hand in words by its two highest cards, e.g. "7-5 low" or "Pair, Fours".
+func (r DeuceToSevenRank) DescribeShort() string {
+	if r.Category() != HandTypeHighCard {
+		return r.HighRank().DescribeShort()
+	}
+	ranks := r.Ranks()
+	return joinRanks(ranks[:min(2, len(ranks))]) + " low"
+}
+
+// EvaluateDeuceToSeven returns the best deuce-to-seven low and its five cards for 5 to 7 cards.
+// The cards are ordered like the ranks of the hand.
+func EvaluateDeuceToSeven(cards []Card) (DeuceToSevenRank, []Card, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be 5 to 7, got %d", len(cards))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
+	}
+
+	var best DeuceToSevenRank
+	var bestFive [5]Card
+	forEachFive(cards, func(five [5]Card) {
+		if rank := NewDeuceToSevenRank(fiveCardRank(&five, RankUnknown)); rank > best {
+			best, bestFive = rank, five
+		}
+	})
+	return best, bestFive[:], nil
+}
+
+// DeuceToSevenEvaluator ranks deuce-to-seven lows like EvaluateDeuceToSeven, as DeuceToSevenRank values.
+var DeuceToSevenEvaluator Evaluator = EvaluatorFunc(func(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateDeuceToSeven(cards)
+	return HandRank(rank), err
+})
+
+// fiveCardRank returns the high HandRank of five cards and sorts them like its ranks.
+// An ace plays low in the straight from wheelTop down, e.g. RankFive for the wheel A-5-4-3-2,
+// and no ace-low straight counts if wheelTop is RankUnknown.
+func fiveCardRank(five *[5]Card, wheelTop Rank) HandRank {
+	var counts [RankAce + 1]int
+	flush := true
+	for _, c := range five {
+		counts[c.Rank]++
+		flush = flush && c.Suit == five[0].Suit
+	}
+	slices.SortFunc(five[:], func(a, b Card) int {
+		return cmp.Or(cmp.Compare(counts[b.Rank], counts[a.Rank]), cmp.Compare(b.Rank, a.Rank))
+	})
+
+	ranks := make([]Rank, 0, len(five))
+	for i, c := range five {
+		if i == 0 || c.Rank != five[i-1].Rank {
+			ranks = append(ranks, c.Rank)
+		}
+	}
+	if len(ranks) < len(five) {
+		return NewHandRank(pairedHandType(counts[five[0].Rank], len(ranks)), ranks...)
+	}
+
+	straight := ranks[0]-ranks[4] == 4
+	if wheelTop != RankUnknown && ranks[0] == RankAce && ranks[1] == wheelTop && ranks[1]-ranks[4] == 3 {
+		straight = true
+		// Play the ace as the lowest card.
+		ace := five[0]
+		copy(five[:], five[1:])
+		five[4] = ace
+		ranks = []Rank{wheelTop}
+	}
+	switch {
+	case straight && flush && ranks[0] == RankAce:
+		return NewHandRank(HandTypeRoyalFlush, RankAce)
+	case straight && flush:
+		return NewHandRank(HandTypeStraightFlush, ranks[0])
+	case flush:
+		return NewHandRank(HandTypeFlush, ranks...)
+	case straight:
+		return NewHandRank(HandTypeStraight, ranks[0])
+	default:
+		return NewHandRank(HandTypeHighCard, ranks...)
+	}
+}
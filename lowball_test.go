@@ -0,0 +1,263 @@
+package poker_test
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluateAceToFive(t *testing.T) {
+	tests := []struct {
+		name      string
+		cards     string
+		wantType  poker.HandType
+		wantRanks []poker.Rank
+		wantFive  string
+		wantLong  string
+		wantShort string
+	}{
+		{
+			name:      "wheel is the nuts",
+			cards:     "5h 4h 3h 2h Ah Kc Kd",
+			wantType:  poker.HandTypeHighCard,
+			wantRanks: []poker.Rank{poker.RankFive, poker.RankFour, poker.RankThree, poker.RankDeuce, poker.RankAce},
+			wantFive:  "5h 4h 3h 2h Ah",
+			wantLong:  "5-4-3-2-A low",
+			wantShort: "5-4 low",
+		},
+		{
+			name:      "pairs are skipped",
+			cards:     "7c 7d 5s 4h 4c 3d Ac",
+			wantType:  poker.HandTypeHighCard,
+			wantRanks: []poker.Rank{poker.RankSeven, poker.RankFive, poker.RankFour, poker.RankThree, poker.RankAce},
+			wantFive:  "7c 5s 4h 3d Ac",
+			wantLong:  "7-5-4-3-A low",
+			wantShort: "7-5 low",
+		},
+		{
+			name:      "pair of aces is the lowest pair",
+			cards:     "Ah Ad 2c 2s 8h 6d 6c",
+			wantType:  poker.HandTypePair,
+			wantRanks: []poker.Rank{poker.RankAce, poker.RankEight, poker.RankSix, poker.RankDeuce},
+			wantFive:  "Ah Ad 8h 6d 2c",
+			wantLong:  "Pair, Aces with Eight-Six-Deuce kickers",
+			wantShort: "Pair, Aces",
+		},
+		{
+			name:      "full house beats quads",
+			cards:     "Kh Kd Kc Ks Qh Qd",
+			wantType:  poker.HandTypeFullHouse,
+			wantRanks: []poker.Rank{poker.RankKing, poker.RankQueen},
+			wantFive:  "Kh Kd Kc Qh Qd",
+			wantLong:  "Full House, Kings full of Queens",
+			wantShort: "Full House, Kings full of Queens",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, five, err := poker.EvaluateAceToFive(poker.MustParseCards(tt.cards))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rank.Category() != tt.wantType {
+				t.Errorf("Category() = %s, want %s", rank.Category(), tt.wantType)
+			}
+			if diff := cmp.Diff(tt.wantRanks, rank.Ranks()); diff != "" {
+				t.Errorf("Ranks() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantFive), five); diff != "" {
+				t.Errorf("five cards mismatch (-want +got):\n%s", diff)
+			}
+			if got := rank.Describe(); got != tt.wantLong {
+				t.Errorf("Describe() = %q, want %q", got, tt.wantLong)
+			}
+			if got := rank.DescribeShort(); got != tt.wantShort {
+				t.Errorf("DescribeShort() = %q, want %q", got, tt.wantShort)
+			}
+			if want := poker.NewLowRank(tt.wantType, tt.wantRanks...); rank != want {
+				t.Errorf("rank = %s, want %s", rank, want)
+			}
+		})
+	}
+}
+
+func TestLowRank_Order(t *testing.T) {
+	hands := []string{
+		"Kh Kd Kc Ks Qh",
+		"Kh Kd Kc Qs Qh",
+		"Kh Kd Kc Qs Jh",
+		"Kh Kd Qc Qs Jh",
+		"Kh Kd Qc Js Th",
+		"2h 2d Ac 4s 3h",
+		"Ah Ad Kc Qs Jh",
+		"Kh Qd Jc Ts 9h",
+		"8h 7d 6c 5s 4h",
+		"8h 5d 4c 3s 2h",
+		"7h 6d 5c 4s 3h",
+		"6h 5d 4c 3s 2h",
+		"6h 4d 3c 2s Ah",
+		"5h 4h 3h 2h Ah",
+	}
+	var prev poker.LowRank
+	for i, h := range hands {
+		rank, _, err := poker.EvaluateAceToFive(poker.MustParseCards(h))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if i > 0 && (!prev.Less(rank) || rank.Compare(prev) != 1) {
+			t.Errorf("%s (%s) should be better than %s (%s)", h, rank, hands[i-1], prev)
+		}
+		prev = rank
+	}
+}
+
+func TestAceToFiveEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "wheel", Hand: poker.MustParseCards("Ah 2d")},
+		{Name: "seven", Hand: poker.MustParseCards("7h 6d")},
+	}
+	board := poker.MustParseCards("3c 4s 5d Kh Kd")
+	winners, err := poker.CompareHands(players, board, poker.WithEvaluator(poker.AceToFiveEvaluator))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(winners) != 1 || winners[0].Name != "wheel" {
+		t.Errorf("winners = %v, want wheel", winners)
+	}
+
+	if _, _, err := poker.EvaluateAceToFive(poker.MustParseCards("Ah 2d 3c 4s")); err == nil {
+		t.Error("expected error for 4 cards")
+	}
+}
+
+func TestEvaluateDeuceToSeven(t *testing.T) {
+	tests := []struct {
+		cards     string
+		wantType  poker.HandType
+		wantFive  string
+		wantLong  string
+		wantShort string
+	}{
+		{cards: "7h 5d 4c 3s 2h Kd Kc", wantType: poker.HandTypeHighCard, wantFive: "7h 5d 4c 3s 2h", wantLong: "number one: 7-5-4-3-2", wantShort: "7-5 low"},
+		{cards: "7h 6d 5c 3s 2h", wantType: poker.HandTypeHighCard, wantFive: "7h 6d 5c 3s 2h", wantLong: "number three: 7-6-5-3-2", wantShort: "7-6 low"},
+		{cards: "Ah 5d 4c 3s 2h", wantType: poker.HandTypeHighCard, wantFive: "Ah 5d 4c 3s 2h", wantLong: "A-5-4-3-2 low", wantShort: "A-5 low"},
+		{cards: "8h 6h 4h 3h 2h 9c", wantType: poker.HandTypeHighCard, wantFive: "9c 6h 4h 3h 2h", wantLong: "9-6-4-3-2 low", wantShort: "9-6 low"},
+		{cards: "6h 5d 4c 3s 2h", wantType: poker.HandTypeStraight, wantFive: "6h 5d 4c 3s 2h", wantLong: "Straight, Six high", wantShort: "Straight, Six high"},
+		{cards: "8h 6h 4h 3h 2h", wantType: poker.HandTypeFlush, wantFive: "8h 6h 4h 3h 2h", wantLong: "Flush, Eight-Six-Four-Three-Deuce high", wantShort: "Flush, Eight-Six high"},
+		{cards: "2h 2d 7c 4s 3h", wantType: poker.HandTypePair, wantFive: "2h 2d 7c 4s 3h", wantLong: "Pair, Deuces with Seven-Four-Three kickers", wantShort: "Pair, Deuces"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantLong, func(t *testing.T) {
+			rank, five, err := poker.EvaluateDeuceToSeven(poker.MustParseCards(tt.cards))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rank.Category() != tt.wantType {
+				t.Errorf("Category() = %s, want %s", rank.Category(), tt.wantType)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantFive), five); diff != "" {
+				t.Errorf("five cards mismatch (-want +got):\n%s", diff)
+			}
+			if got := rank.Describe(); got != tt.wantLong {
+				t.Errorf("Describe() = %q, want %q", got, tt.wantLong)
+			}
+			if got := rank.DescribeShort(); got != tt.wantShort {
+				t.Errorf("DescribeShort() = %q, want %q", got, tt.wantShort)
+			}
+		})
+	}
+}
+
+// deuceToSevenReference returns a key of a five-card hand that orders hands from the best
+// to the worst deuce-to-seven low when compared lexicographically, computed independently
+// of the package: the category, then the ranks by count and by rank.
+func deuceToSevenReference(cards []poker.Card) []int {
+	counts := make(map[poker.Rank]int)
+	suits := make(map[poker.Suit]bool)
+	for _, c := range cards {
+		counts[c.Rank]++
+		suits[c.Suit] = true
+	}
+	var ranks []poker.Rank
+	for r := range counts {
+		ranks = append(ranks, r)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if counts[ranks[i]] != counts[ranks[j]] {
+			return counts[ranks[i]] > counts[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+
+	straight := len(ranks) == 5 && ranks[0]-ranks[4] == 4
+	flush := len(suits) == 1
+	var category int
+	switch {
+	case straight && flush:
+		category = 8
+	case counts[ranks[0]] == 4:
+		category = 7
+	case counts[ranks[0]] == 3 && counts[ranks[1]] == 2:
+		category = 6
+	case flush:
+		category = 5
+	case straight:
+		category = 4
+	case counts[ranks[0]] == 3:
+		category = 3
+	case counts[ranks[0]] == 2 && counts[ranks[1]] == 2:
+		category = 2
+	case counts[ranks[0]] == 2:
+		category = 1
+	}
+
+	key := []int{category}
+	for _, r := range ranks {
+		key = append(key, int(r))
+	}
+	return key
+}
+
+func TestEvaluateDeuceToSeven_Exhaustive(t *testing.T) {
+	deck := poker.NewDeck().Cards
+	keys := make(map[poker.DeuceToSevenRank][]int)
+	hand := make([]poker.Card, 5)
+	for a := 0; a < len(deck); a++ {
+		for b := a + 1; b < len(deck); b++ {
+			for c := b + 1; c < len(deck); c++ {
+				for d := c + 1; d < len(deck); d++ {
+					for e := d + 1; e < len(deck); e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						rank, _, err := poker.EvaluateDeuceToSeven(hand)
+						if err != nil {
+							t.Fatalf("unexpected error: %s", err)
+						}
+						key := deuceToSevenReference(hand)
+						if prev, ok := keys[rank]; !ok {
+							keys[rank] = key
+						} else if !slices.Equal(prev, key) {
+							t.Fatalf("%s is %s like a hand with key %v, but its key is %v", poker.Cards(hand), rank, prev, key)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if len(keys) != poker.NumHandClasses {
+		t.Errorf("got %d classes, want %d", len(keys), poker.NumHandClasses)
+	}
+	ranks := slices.Sorted(maps.Keys(keys))
+	for i := 1; i < len(ranks); i++ {
+		// ranks are sorted from the worst to the best, so keys must decrease.
+		if slices.Compare(keys[ranks[i]], keys[ranks[i-1]]) >= 0 {
+			t.Errorf("%s (key %v) is better than %s (key %v)", ranks[i], keys[ranks[i]], ranks[i-1], keys[ranks[i-1]])
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+// A card is encoded in short notation such as "Ah".
+func (c Card) MarshalText() ([]byte, error) {
+	if _, err := c.Rank.MarshalText(); err != nil {
+		return nil, err
+	}
+	if _, err := c.Suit.MarshalText(); err != nil {
+		return nil, err
+	}
+	return []byte(c.StringShort()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts any notation that ParseCard accepts.
+func (c *Card) UnmarshalText(text []byte) error {
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+	*c = card
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// A rank is encoded as a single character such as "A" or "T", and a joker as "X".
+func (r Rank) MarshalText() ([]byte, error) {
+	if r < RankDeuce || r > RankJoker {
+		return nil, fmt.Errorf("invalid rank: %d", int(r))
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *Rank) UnmarshalText(text []byte) error {
+	s := string(text)
+	if s == "" {
+		return fmt.Errorf("invalid rank: %q", s)
+	}
+	rank, next := parseRankAt(s, 0)
+	if rank == RankUnknown || next != len(s) {
+		return fmt.Errorf("invalid rank: %q", s)
+	}
+	*r = rank
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// A suit is encoded as a single character such as "h".
+func (s Suit) MarshalText() ([]byte, error) {
+	if s < Hearts || s > Spades {
+		return nil, fmt.Errorf("invalid suit: %d", int(s))
+	}
+	return []byte(s.StringShort()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Both the short ("h") and the long ("hearts") forms are accepted.
+func (s *Suit) UnmarshalText(text []byte) error {
+	suit := UnmarshalSuitString(strings.ToLower(string(text)))
+	if suit < 0 {
+		return fmt.Errorf("invalid suit: %q", string(text))
+	}
+	*s = suit
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// A hand type is encoded by its name such as "Full House".
+func (h HandType) MarshalText() ([]byte, error) {
+	if h < HandTypeUnknown || h > HandTypeFiveOfAKind {
+		return nil, fmt.Errorf("invalid hand type: %d", int(h))
+	}
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The name is matched case-insensitively.
+func (h *HandType) UnmarshalText(text []byte) error {
+	for ht := HandTypeUnknown; ht <= HandTypeFiveOfAKind; ht++ {
+		if strings.EqualFold(string(text), ht.String()) {
+			*h = ht
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid hand type: %q", string(text))
+}
@@ -0,0 +1,106 @@
+package poker_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestCard_JSON(t *testing.T) {
+	type hand struct {
+		Cards []poker.Card        `json:"cards"`
+		Rank  poker.Rank          `json:"rank"`
+		Suit  poker.Suit          `json:"suit"`
+		Type  poker.HandType      `json:"type"`
+		Seen  map[poker.Card]bool `json:"seen"`
+	}
+
+	in := hand{
+		Cards: poker.MustParseCards("Ah Td 2c"),
+		Rank:  poker.RankQueen,
+		Suit:  poker.Hearts,
+		Type:  poker.HandTypeFullHouse,
+		Seen:  map[poker.Card]bool{{Rank: poker.RankKing, Suit: poker.Spades}: true},
+	}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := `{"cards":["Ah","Td","2c"],"rank":"Q","suit":"h","type":"Full House","seen":{"Ks":true}}`
+	if diff := cmp.Diff(want, string(b)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	var out hand
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		target  interface{ UnmarshalText([]byte) error }
+		want    any
+		wantErr bool
+	}{
+		{name: "card long form", input: "A hearts", target: new(poker.Card), want: poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}},
+		{name: "rank 10", input: "10", target: new(poker.Rank), want: poker.RankTen},
+		{name: "rank lower case", input: "k", target: new(poker.Rank), want: poker.RankKing},
+		{name: "suit long form", input: "spades", target: new(poker.Suit), want: poker.Spades},
+		{name: "hand type lower case", input: "two pair", target: new(poker.HandType), want: poker.HandTypeTwoPair},
+		{name: "invalid card", input: "Zz", target: new(poker.Card), wantErr: true},
+		{name: "invalid rank", input: "1", target: new(poker.Rank), wantErr: true},
+		{name: "empty rank", input: "", target: new(poker.Rank), wantErr: true},
+		{name: "invalid suit", input: "x", target: new(poker.Suit), wantErr: true},
+		{name: "invalid hand type", input: "Six of a Kind", target: new(poker.HandType), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.target.UnmarshalText([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var got any
+			switch v := tt.target.(type) {
+			case *poker.Card:
+				got = *v
+			case *poker.Rank:
+				got = *v
+			case *poker.Suit:
+				got = *v
+			case *poker.HandType:
+				got = *v
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMarshalText_Invalid(t *testing.T) {
+	if _, err := json.Marshal(poker.Card{}); err == nil {
+		t.Error("expected error for zero card, got nil")
+	}
+	if _, err := json.Marshal(poker.Suit(-1)); err == nil {
+		t.Error("expected error for invalid suit, got nil")
+	}
+	if _, err := json.Marshal(poker.HandType(12)); err == nil {
+		t.Error("expected error for invalid hand type, got nil")
+	}
+}
@@ -0,0 +1,111 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// EvaluateOmaha returns the best Omaha high hand and its five cards, which use exactly
+// two of the 4 to 6 hole cards and exactly three of the 3 to 5 board cards.
+// The cards are ordered like the ranks of the HandRank.
+func EvaluateOmaha(hole, board []Card) (HandRank, []Card, error) {
+	if err := validateOmaha(hole, board); err != nil {
+		return 0, nil, err
+	}
+	var best HandRank
+	var bestFive [5]Card
+	forEachOmahaFive(hole, board, func(five [5]Card) {
+		if rank := fiveCardRank(&five, RankFive); rank > best {
+			best, bestFive = rank, five
+		}
+	})
+	return best, bestFive[:], nil
+}
+
+// EvaluateOmahaLow returns the best ace-to-five low and its five cards under the same
+// two-plus-three rule as EvaluateOmaha. Use LowRank.Qualifies to check for an eight or better.
+func EvaluateOmahaLow(hole, board []Card) (LowRank, []Card, error) {
+	if err := validateOmaha(hole, board); err != nil {
+		return 0, nil, err
+	}
+	var best LowRank
+	var bestFive [5]Card
+	forEachOmahaFive(hole, board, func(five [5]Card) {
+		if rank := aceToFiveRank(&five); rank > best {
+			best, bestFive = rank, five
+		}
+	})
+	return best, bestFive[:], nil
+}
+
+// OmahaEvaluator ranks Omaha high hands like EvaluateOmaha. Evaluate expects the HoleCards
+// hole cards followed by the board, which is how the comparison and equity functions
+// pass the hand of a player, e.g. WithEvaluator(OmahaEvaluator{HoleCards: 4}) for PLO.
+type OmahaEvaluator struct {
+	// HoleCards is the number of hole cards: 4 for PLO, 5 for PLO5 and 6 for PLO6.
+	HoleCards int
+}
+
+func (e OmahaEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	hole, board, err := splitOmaha(cards, e.HoleCards)
+	if err != nil {
+		return 0, err
+	}
+	rank, _, err := EvaluateOmaha(hole, board)
+	return rank, err
+}
+
+// OmahaEightOrBetterEvaluator ranks the low half of Omaha hi-lo like EvaluateOmahaLow,
+// and returns 0 for hands without a low of eight or better. It takes the cards like OmahaEvaluator,
+// e.g. WithLowEvaluator(OmahaEightOrBetterEvaluator{HoleCards: 4}) for PLO8.
+type OmahaEightOrBetterEvaluator struct {
+	// HoleCards is the number of hole cards: 4 for PLO, 5 for PLO5 and 6 for PLO6.
+	HoleCards int
+}
+
+func (e OmahaEightOrBetterEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	hole, board, err := splitOmaha(cards, e.HoleCards)
+	if err != nil {
+		return 0, err
+	}
+	rank, _, err := EvaluateOmahaLow(hole, board)
+	if err != nil || !rank.Qualifies(RankEight) {
+		return 0, err
+	}
+	return HandRank(rank), nil
+}
+
+func splitOmaha(cards []Card, holeCards int) ([]Card, []Card, error) {
+	if holeCards < 4 || holeCards > 6 || len(cards) < holeCards {
+		return nil, nil, fmt.Errorf("invalid number of cards for %d hole cards: %d", holeCards, len(cards))
+	}
+	return cards[:holeCards], cards[holeCards:], nil
+}
+
+func validateOmaha(hole, board []Card) error {
+	if len(hole) < 4 || len(hole) > 6 {
+		return fmt.Errorf("invalid number of hole cards: must be 4 to 6, got %d", len(hole))
+	}
+	if len(board) < 3 || len(board) > 5 {
+		return fmt.Errorf("invalid number of board cards: must be 3 to 5, got %d", len(board))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, hole...); err != nil {
+		return err
+	}
+	return v.add(OwnerBoard, board...)
+}
+
+// forEachOmahaFive calls fn with every five cards made of two hole cards and three board cards.
+func forEachOmahaFive(hole, board []Card, fn func(five [5]Card)) {
+	for a := 0; a < len(hole); a++ {
+		for b := a + 1; b < len(hole); b++ {
+			for c := 0; c < len(board); c++ {
+				for d := c + 1; d < len(board); d++ {
+					for e := d + 1; e < len(board); e++ {
+						fn([5]Card{hole[a], hole[b], board[c], board[d], board[e]})
+					}
+				}
+			}
+		}
+	}
+}
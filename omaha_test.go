@@ -0,0 +1,172 @@
+package poker_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluateOmaha(t *testing.T) {
+	tests := []struct {
+		name     string
+		hole     string
+		board    string
+		wantType poker.HandType
+		wantFive string
+	}{
+		{
+			name:     "one hole card does not make a flush",
+			hole:     "Ah Kd Qs Jc",
+			board:    "2h 3h 4h 5h 9h",
+			wantType: poker.HandTypeHighCard,
+			wantFive: "Ah Kd 9h 5h 4h",
+		},
+		{
+			name:     "three hole cards do not make quads",
+			hole:     "As Ad Ac 2d",
+			board:    "Ah Ks Qd 7c 3s",
+			wantType: poker.HandTypeThreeOfAKind,
+			wantFive: "As Ad Ah Ks Qd",
+		},
+		{
+			name:     "wheel with two hole cards",
+			hole:     "Ah 2d Kc Ks 9s 9d",
+			board:    "3c 4s 5d Qh",
+			wantType: poker.HandTypeStraight,
+			wantFive: "5d 4s 3c 2d Ah",
+		},
+		{
+			name:     "PLO5 flush",
+			hole:     "Kh Th 7c 7d 2s",
+			board:    "Ah 9h 4h 4c Qs",
+			wantType: poker.HandTypeFlush,
+			wantFive: "Ah Kh Th 9h 4h",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, five, err := poker.EvaluateOmaha(poker.MustParseCards(tt.hole), poker.MustParseCards(tt.board))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rank.Category() != tt.wantType {
+				t.Errorf("Category() = %s, want %s", rank.Category(), tt.wantType)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantFive), five); diff != "" {
+				t.Errorf("five cards mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestEvaluateOmaha_Random checks EvaluateOmaha against the best RuleEvaluator rank of
+// every two hole cards with every three board cards.
+func TestEvaluateOmaha_Random(t *testing.T) {
+	deck := poker.NewDeck(poker.WithRandSource(rand.NewPCG(3, 4)))
+	for i := range 500 {
+		deck.Shuffle()
+		hole := deck.Cards[:4+i%3]
+		board := deck.Cards[6 : 6+3+i%3]
+
+		var want poker.HandRank
+		for _, h := range poker.AllCombinations(hole, 2) {
+			for _, b := range poker.AllCombinations(board, 3) {
+				rank, err := poker.RuleEvaluator.Evaluate(append(h, b...))
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				want = max(want, rank)
+			}
+		}
+
+		got, _, err := poker.EvaluateOmaha(hole, board)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != want {
+			t.Fatalf("hole %s board %s: got %s, want %s", poker.Cards(hole), poker.Cards(board), got, want)
+		}
+	}
+}
+
+func TestEvaluateOmaha_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  string
+		board string
+	}{
+		{name: "hold'em hand", hole: "Ah Kd", board: "2h 3h 4h"},
+		{name: "seven hole cards", hole: "Ah Kd Qs Jc Td 9c 8s", board: "2h 3h 4h"},
+		{name: "preflop", hole: "Ah Kd Qs Jc", board: ""},
+		{name: "card on hole and board", hole: "Ah Kd Qs Jc", board: "Ah 3h 4h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := poker.EvaluateOmaha(poker.MustParseCards(tt.hole), poker.MustParseCards(tt.board)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestOmahaEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "flush draw", Hand: poker.MustParseCards("Ah Kd Qs Jc")},
+		{Name: "set", Hand: poker.MustParseCards("9s 9d 3c 2c")},
+	}
+	board := poker.MustParseCards("2h 3h 4h 5h 9h")
+
+	// In hold'em the ace would make a straight flush.
+	winners, err := poker.CompareHands(players, board, poker.WithEvaluator(poker.OmahaEvaluator{HoleCards: 4}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(winners) != 1 || winners[0].Name != "set" {
+		t.Errorf("winners = %v, want set", winners)
+	}
+
+	equities, err := poker.EvaluateEquityByMadeHandWithCommunity(players, board[:4], poker.WithEvaluator(poker.OmahaEvaluator{HoleCards: 4}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The shares of every board add up to one.
+	var total float64
+	for _, e := range equities {
+		total += e
+	}
+	if diff := cmp.Diff(1.0, total, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("total equity mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := (poker.OmahaEvaluator{HoleCards: 4}).Evaluate(poker.MustParseCards("Ah Kd 2h 3h 4h")); err == nil {
+		t.Error("expected error for two hole cards")
+	}
+}
+
+func TestCompareHandsHiLo_Omaha(t *testing.T) {
+	players := []poker.Player{
+		{Name: "p0", Hand: poker.MustParseCards("Ah 2d Kc Ks")},
+		{Name: "p1", Hand: poker.MustParseCards("Qs Qc 5c 6c")},
+		{Name: "p2", Hand: poker.MustParseCards("Jd Td 2s 2h")},
+	}
+	got, err := poker.CompareHandsHiLo(players, poker.MustParseCards("3c 4s 8d Qh Jh"),
+		poker.WithEvaluator(poker.OmahaEvaluator{HoleCards: 4}),
+		poker.WithLowEvaluator(poker.OmahaEightOrBetterEvaluator{HoleCards: 4}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"p1"}, playerNames(got.High)); diff != "" {
+		t.Errorf("High mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"p0"}, playerNames(got.Low)); diff != "" {
+		t.Errorf("Low mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]float64{0.5, 0.5, 0}, got.Shares); diff != "" {
+		t.Errorf("Shares mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,87 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Option configures the equity, comparison and outs calculations.
+type Option func(*options)
+
+type options struct {
+	dead         CardSet
+	deck         *Deck
+	evaluator    Evaluator
+	lowEvaluator Evaluator
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithDeadCards excludes the given cards from the remaining deck,
+// e.g. cards that were folded or exposed.
+func WithDeadCards(dead CardSet) Option {
+	return func(o *options) {
+		o.dead = o.dead.Union(dead)
+	}
+}
+
+// WithDeck enumerates the remaining cards from deck instead of a standard 52-card deck,
+// e.g. a short deck built by NewDeck(WithShortDeck()). The deck is not modified.
+// The deck must not hold a card twice, so a shoe built by WithDecks is rejected.
+func WithDeck(deck *Deck) Option {
+	return func(o *options) {
+		o.deck = deck
+	}
+}
+
+// validateDeal is like ValidateDeal with the dead cards, but accepts jokers
+// if the evaluator accepts them.
+func (o *options) validateDeal(players []Player, board []Card) error {
+	return validateDeal(players, board, o.dead, o.wild())
+}
+
+// wild reports whether the evaluator given by WithEvaluator accepts jokers.
+func (o *options) wild() bool {
+	a, ok := o.evaluator.(JokerAccepter)
+	return ok && a.AcceptsJokers()
+}
+
+// remainingDeck returns the deck to enumerate once the known cards and the dead cards are removed.
+// It returns a *CardNotInDeckError if a known card is not in the deck. Dead cards that are
+// not in the deck are ignored.
+func (o *options) remainingDeck(known []Card) (*Deck, error) {
+	var deck *Deck
+	if o.deck != nil {
+		deck = o.deck.Clone()
+	} else {
+		deck = NewDeck()
+	}
+
+	for _, c := range known {
+		if !deck.RemoveCard(c) {
+			return nil, &CardNotInDeckError{Card: c}
+		}
+	}
+	for c := range o.dead.All() {
+		deck.RemoveCard(c)
+	}
+
+	// The evaluators assume distinct cards, which are natural unless jokers are wild.
+	var seen CardSet
+	for _, c := range slices.Concat(deck.Cards, known) {
+		if c.Rank == RankJoker && !o.wild() {
+			return nil, fmt.Errorf("jokers are not supported: %s", c)
+		}
+		if seen.Contains(c) {
+			return nil, fmt.Errorf("duplicate card %s: decks with more than one copy of a card are not supported", c.StringShort())
+		}
+		seen = seen.Add(c)
+	}
+	return deck, nil
+}
@@ -11,7 +11,10 @@ const selfPlayerName = "self"
 // change the result from self losing to self winning against all opponents.
 // holeCards must be exactly 2 cards. board must be 3, 4, or 5 cards.
 // Each opponent must have exactly 2 cards.
-func CalculateOuts(holeCards []Card, board []Card, opponents [][]Card) ([]Card, error) {
+// Outs are taken from the deck given by WithDeck, or a standard deck otherwise.
+// Dead cards given by WithDeadCards are never counted as outs.
+// Hands are ranked by LookupEvaluator unless WithEvaluator is given.
+func CalculateOuts(holeCards []Card, board []Card, opponents [][]Card, opts ...Option) ([]Card, error) {
 	if len(holeCards) != 2 {
 		return nil, fmt.Errorf("holeCards must be exactly 2 cards, got %d", len(holeCards))
 	}
@@ -24,30 +27,26 @@ func CalculateOuts(holeCards []Card, board []Card, opponents [][]Card) ([]Card,
 		}
 	}
 
-	if len(board) == 5 {
-		return nil, nil
-	}
-
 	players := make([]Player, 0, len(opponents)+1)
 	players = append(players, Player{Name: selfPlayerName, Hand: holeCards})
 	for i, opp := range opponents {
 		players = append(players, Player{Name: fmt.Sprintf("opponent%d", i), Hand: opp})
 	}
 
-	deck := NewDeck()
-	for _, c := range holeCards {
-		deck.RemoveCard(c)
+	o := newOptions(opts)
+	if err := o.validateDeal(players, board); err != nil {
+		return nil, err
 	}
-	for _, c := range board {
-		deck.RemoveCard(c)
+	deck, err := o.remainingDeck(append(playersCards(players), board...))
+	if err != nil {
+		return nil, err
 	}
-	for _, opp := range opponents {
-		for _, c := range opp {
-			deck.RemoveCard(c)
-		}
+	if len(board) == 5 {
+		return nil, nil
 	}
 
-	beforeWinners, err := compareWinners(players, board)
+	e := o.evaluatorOr(LookupEvaluator)
+	beforeWinners, err := compareHandsWith(e, players, board)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compare before: %w", err)
 	}
@@ -60,7 +59,7 @@ func CalculateOuts(holeCards []Card, board []Card, opponents [][]Card) ([]Card,
 	for _, card := range deck.Cards {
 		newBoard[len(board)] = card
 
-		afterWinners, err := compareWinners(players, newBoard)
+		afterWinners, err := compareHandsWith(e, players, newBoard)
 		if err != nil {
 			return nil, fmt.Errorf("failed to compare after adding %v: %w", card, err)
 		}
@@ -73,94 +72,13 @@ func CalculateOuts(holeCards []Card, board []Card, opponents [][]Card) ([]Card,
 	return outs, nil
 }
 
-// compareWinners determines the winner(s) among players given a board.
-// Uses CompareHandsByMadeHand for 5-card boards (7 total cards with 2 hole cards),
-// falls back to evaluate-based comparison for shorter boards.
-func compareWinners(players []Player, board []Card) ([]Player, error) {
-	if len(board) == 5 {
-		return CompareHandsByMadeHand(players, board)
-	}
-	return compareHandsByEval(players, board)
-}
-
-// compareHandsByEval compares hands using evaluate() for non-7-card totals.
-func compareHandsByEval(players []Player, board []Card) ([]Player, error) {
-	type eval struct {
-		player   Player
-		handType HandType
-		rankKey  []Rank
-	}
-
-	var best *eval
-	var tied []Player
-
-	for _, p := range players {
-		cards := make([]Card, len(p.Hand)+len(board))
-		copy(cards, p.Hand)
-		copy(cards[len(p.Hand):], board)
-
-		handType, hand, err := evaluate(cards)
-		if err != nil {
-			return nil, fmt.Errorf("failed to evaluate %s: %w", p.Name, err)
-		}
-
-		e := &eval{
-			player:   p,
-			handType: handType,
-			rankKey:  handRankKey(handType, hand),
-		}
-
-		if best == nil {
-			best = e
-			continue
-		}
-
-		cmp := compareRankedHands(best.handType, best.rankKey, e.handType, e.rankKey)
-		switch {
-		case cmp < 0:
-			best = e
-			tied = nil
-		case cmp == 0:
-			tied = append(tied, p)
-		}
-	}
-
-	if len(tied) == 0 {
-		return []Player{best.player}, nil
-	}
-	result := make([]Player, 0, len(tied)+1)
-	result = append(result, tied...)
-	result = append(result, best.player)
-	return result, nil
-}
-
-// compareRankedHands compares two hands by HandType and rank key.
-// Returns >0 if a is better, <0 if b is better, 0 if tie.
-func compareRankedHands(aType HandType, aKey []Rank, bType HandType, bKey []Rank) int {
-	if aType != bType {
-		if aType > bType {
-			return 1
-		}
-		return -1
-	}
-	for i := range min(len(aKey), len(bKey)) {
-		if aKey[i] > bKey[i] {
-			return 1
-		}
-		if aKey[i] < bKey[i] {
-			return -1
-		}
-	}
-	return 0
-}
-
 // handRankKey extracts comparison-relevant ranks in priority order.
 // The returned slice can be compared lexicographically to determine the stronger hand
 // within the same HandType.
 func handRankKey(handType HandType, hand []Card) []Rank {
 	switch handType {
 	case HandTypeRoyalFlush:
-		return nil
+		return []Rank{RankAce}
 	case HandTypeStraightFlush:
 		// evaluate() returns ascending [low..high]
 		if hand[4].Rank == RankAce && hand[0].Rank == RankDeuce {
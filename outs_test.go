@@ -241,3 +241,39 @@ func BenchmarkCalculateOuts(b *testing.B) {
 		_, _ = CalculateOuts(holeCards, board, opponents)
 	}
 }
+
+func TestCalculateOuts_DeadCards(t *testing.T) {
+	holeCards := []Card{{RankKing, Hearts}, {RankQueen, Diamonds}}
+	board := []Card{{RankJack, Clubs}, {RankNine, Clubs}, {RankEight, Clubs}, {RankThree, Spades}}
+	opponents := [][]Card{{{RankSeven, Hearts}, {RankSeven, Diamonds}}}
+	dead := NewCardSet(Card{RankTen, Hearts}, Card{RankKing, Clubs})
+
+	got, err := CalculateOuts(holeCards, board, opponents, WithDeadCards(dead))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 8 {
+		t.Errorf("outs count = %d, want 8", len(got))
+	}
+	for _, c := range got {
+		if dead.Contains(c) {
+			t.Errorf("dead card %v counted as an out", c)
+		}
+	}
+}
+
+func TestCalculateOuts_WithDeck(t *testing.T) {
+	holeCards := []Card{{RankSix, Hearts}, {RankSeven, Hearts}}
+	board := []Card{{RankEight, Clubs}, {RankNine, Diamonds}, {RankAce, Spades}, {RankKing, Spades}}
+	opponents := [][]Card{{{RankAce, Hearts}, {RankQueen, Diamonds}}}
+
+	// Only the tens complete the straight, and every one of them exists in a short deck.
+	got, err := CalculateOuts(holeCards, board, opponents, WithDeck(NewDeck(WithShortDeck())))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Card{{RankTen, Hearts}, {RankTen, Clubs}, {RankTen, Diamonds}, {RankTen, Spades}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("outs mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,129 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is returned when card notation cannot be parsed.
+type ParseError struct {
+	Input  string
+	Pos    int
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid card notation %q at position %d: %s", e.Input, e.Pos, e.Reason)
+}
+
+// ParseCard parses a single card such as "Ah", "Td", "10h" or "A hearts".
+// Jokers are written with the rank "X": "Xh" for RedJoker and "Xs" for BlackJoker.
+func ParseCard(s string) (Card, error) {
+	start := skipSeparators(s, 0)
+	if start >= len(s) {
+		return Card{}, &ParseError{Input: s, Pos: start, Reason: "no card"}
+	}
+	card, next, err := parseCardAt(s, start)
+	if err != nil {
+		return Card{}, err
+	}
+	if end := skipSeparators(s, next); end != len(s) {
+		return Card{}, &ParseError{Input: s, Pos: end, Reason: "unexpected trailing input"}
+	}
+	return card, nil
+}
+
+// ParseCards parses a list of cards such as "AhKd", "Qs Jh 2c" or "A hearts, K diamonds".
+// Cards may be concatenated or separated by spaces or commas. Duplicate cards are rejected.
+func ParseCards(s string) ([]Card, error) {
+	var cards []Card
+	seen := make(map[Card]bool)
+
+	pos := skipSeparators(s, 0)
+	for pos < len(s) {
+		card, next, err := parseCardAt(s, pos)
+		if err != nil {
+			return nil, err
+		}
+		if seen[card] {
+			return nil, &ParseError{Input: s, Pos: pos, Reason: fmt.Sprintf("duplicate card %s", card.StringShort())}
+		}
+		seen[card] = true
+		cards = append(cards, card)
+		pos = skipSeparators(s, next)
+	}
+
+	return cards, nil
+}
+
+// MustParseCards is like ParseCards but panics if the input cannot be parsed.
+// It is intended for tests and examples.
+func MustParseCards(s string) []Card {
+	cards, err := ParseCards(s)
+	if err != nil {
+		panic(err)
+	}
+	return cards
+}
+
+// parseCardAt parses one card starting at pos and returns the position just after it.
+func parseCardAt(s string, pos int) (Card, int, error) {
+	rank, next := parseRankAt(s, pos)
+	if rank == RankUnknown {
+		return Card{}, pos, &ParseError{Input: s, Pos: pos, Reason: fmt.Sprintf("unknown rank %q", s[pos:next])}
+	}
+
+	// The long form produced by Card.String() has a space between rank and suit.
+	pos = next
+	for pos < len(s) && s[pos] == ' ' {
+		pos++
+	}
+	if pos >= len(s) {
+		return Card{}, pos, &ParseError{Input: s, Pos: pos, Reason: "missing suit"}
+	}
+
+	suit, next := parseSuitAt(s, pos)
+	if suit < 0 {
+		return Card{}, pos, &ParseError{Input: s, Pos: pos, Reason: fmt.Sprintf("unknown suit %q", s[pos:next])}
+	}
+	if rank == RankJoker && !isJoker(Card{Rank: rank, Suit: suit}) {
+		return Card{}, pos, &ParseError{Input: s, Pos: pos, Reason: fmt.Sprintf("joker must be red (h) or black (s), got %q", s[pos:next])}
+	}
+	if next < len(s) && !isSeparator(s[next]) && !isRankStart(s[next]) {
+		return Card{}, next, &ParseError{Input: s, Pos: next, Reason: fmt.Sprintf("unexpected character %q", s[next])}
+	}
+
+	return Card{Rank: rank, Suit: suit}, next, nil
+}
+
+func parseRankAt(s string, pos int) (Rank, int) {
+	if strings.HasPrefix(s[pos:], "10") {
+		return RankTen, pos + 2
+	}
+	return UnmarshalRankString(strings.ToUpper(s[pos : pos+1])), pos + 1
+}
+
+func parseSuitAt(s string, pos int) (Suit, int) {
+	for _, suit := range []Suit{Hearts, Clubs, Diamonds, Spades} {
+		word := suit.String()
+		if len(s)-pos >= len(word) && strings.EqualFold(s[pos:pos+len(word)], word) {
+			return suit, pos + len(word)
+		}
+	}
+	return UnmarshalSuitString(strings.ToLower(s[pos : pos+1])), pos + 1
+}
+
+func isRankStart(b byte) bool {
+	return strings.IndexByte("23456789TJQKAXtjqkax1", b) >= 0
+}
+
+func isSeparator(b byte) bool {
+	return b == ' ' || b == ',' || b == '\t' || b == '\n'
+}
+
+func skipSeparators(s string, pos int) int {
+	for pos < len(s) && isSeparator(s[pos]) {
+		pos++
+	}
+	return pos
+}
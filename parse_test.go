@@ -0,0 +1,168 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    poker.Card
+		wantErr bool
+	}{
+		{name: "short", input: "Ah", want: poker.Card{Rank: poker.RankAce, Suit: poker.Hearts}},
+		{name: "ten as T", input: "Td", want: poker.Card{Rank: poker.RankTen, Suit: poker.Diamonds}},
+		{name: "ten as 10", input: "10h", want: poker.Card{Rank: poker.RankTen, Suit: poker.Hearts}},
+		{name: "lower case", input: "qs", want: poker.Card{Rank: poker.RankQueen, Suit: poker.Spades}},
+		{name: "long form", input: "K clubs", want: poker.Card{Rank: poker.RankKing, Suit: poker.Clubs}},
+		{name: "surrounding spaces", input: " 2c ", want: poker.Card{Rank: poker.RankDeuce, Suit: poker.Clubs}},
+		{name: "empty", input: "", wantErr: true},
+		{name: "unknown rank", input: "Zh", wantErr: true},
+		{name: "unknown suit", input: "Ax", wantErr: true},
+		{name: "missing suit", input: "A", wantErr: true},
+		{name: "two cards", input: "AhKd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := poker.ParseCard(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []poker.Card
+		wantPos int
+		wantErr bool
+	}{
+		{
+			name:  "concatenated",
+			input: "AhKd",
+			want: []poker.Card{
+				{Rank: poker.RankAce, Suit: poker.Hearts},
+				{Rank: poker.RankKing, Suit: poker.Diamonds},
+			},
+		},
+		{
+			name:  "space separated",
+			input: "Qs Jh 2c",
+			want: []poker.Card{
+				{Rank: poker.RankQueen, Suit: poker.Spades},
+				{Rank: poker.RankJack, Suit: poker.Hearts},
+				{Rank: poker.RankDeuce, Suit: poker.Clubs},
+			},
+		},
+		{
+			name:  "long form with commas",
+			input: "A hearts, 10 diamonds",
+			want: []poker.Card{
+				{Rank: poker.RankAce, Suit: poker.Hearts},
+				{Rank: poker.RankTen, Suit: poker.Diamonds},
+			},
+		},
+		{
+			name:  "empty",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:    "duplicate",
+			input:   "Ah Kd Ah",
+			wantPos: 6,
+			wantErr: true,
+		},
+		{
+			name:    "bad suit in the middle",
+			input:   "AhKx",
+			wantPos: 3,
+			wantErr: true,
+		},
+		{
+			name:    "unexpected character",
+			input:   "Ah/Kd",
+			wantPos: 2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := poker.ParseCards(tt.input)
+			if tt.wantErr {
+				var perr *poker.ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("expected *ParseError, got %v", err)
+				}
+				if perr.Pos != tt.wantPos {
+					t.Errorf("error position = %d, want %d", perr.Pos, tt.wantPos)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCards_RoundTrip(t *testing.T) {
+	for _, c := range poker.NewDeck().Cards {
+		for _, s := range []string{c.String(), c.StringShort()} {
+			got, err := poker.ParseCard(s)
+			if err != nil {
+				t.Fatalf("ParseCard(%q): %s", s, err)
+			}
+			if got != c {
+				t.Errorf("ParseCard(%q) = %v, want %v", s, got, c)
+			}
+		}
+	}
+}
+
+func TestMustParseCards(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	poker.MustParseCards("Ah Ah")
+}
+
+func TestParseCard_Joker(t *testing.T) {
+	got, err := poker.ParseCards("Xh Xs")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]poker.Card{poker.RedJoker, poker.BlackJoker}, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, s := range []string{"Xc", "Xd"} {
+		if _, err := poker.ParseCard(s); err == nil {
+			t.Errorf("ParseCard(%q) expected error, got nil", s)
+		}
+	}
+}
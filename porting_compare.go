@@ -4,51 +4,49 @@ import (
 	"fmt"
 )
 
-// CompareVSMadeHand compares the hand of a player against all possible boards.
+// CompareVSMadeHand checks that RuleEvaluator and LookupEvaluator agree on the hand of
+// a player on all possible boards, and returns an error describing the first discrepancy.
+//
+// Deprecated: Use CheckEvaluators with WithFixedCards, which returns every discrepancy.
 func CompareVSMadeHand(p1 Player) error {
-	deck := NewDeck()
-	for _, c := range p1.Hand {
-		deck.RemoveCard(c)
+	if err := ValidateDeal([]Player{p1}, nil, 0); err != nil {
+		return err
 	}
-
-	for _, board := range AllCombinations(deck.Cards, 5) {
-		madehand := NewBestMadeHand(append(p1.Hand, board...))
-
-		score, hand, err := p1.Evaluate(board)
-		if err != nil {
-			return err
-		}
-
-		if madehand.Type() != score {
-			fmt.Println("madehand:", madehand.Type(), "score:", score, "hand:", hand, "board:", board, "p1:", p1.Hand)
-		}
+	report, err := CheckEvaluators(RuleEvaluator, LookupEvaluator, WithFixedCards(p1.Hand...))
+	if err != nil {
+		return err
+	}
+	if len(report.Discrepancies) > 0 {
+		return fmt.Errorf("found %d discrepancies between the evaluators, first: %s",
+			len(report.Discrepancies), report.Discrepancies[0])
 	}
-
 	return nil
 }
 
 // EvaluateEquityByMadeHand returns the equity of each player in the game.
-func EvaluateEquityByMadeHand(players []Player) ([]float64, error) {
-	return EvaluateEquityByMadeHandWithCommunity(players, nil)
+func EvaluateEquityByMadeHand(players []Player, opts ...Option) ([]float64, error) {
+	return EvaluateEquityByMadeHandWithCommunity(players, nil, opts...)
 }
 
-func EvaluateEquityByMadeHandWithCommunity(players []Player, community []Card) ([]float64, error) {
-	deck := NewDeck()
-
-	for _, p := range players {
-		for _, c := range p.Hand {
-			deck.RemoveCard(c)
-		}
+// EvaluateEquityByMadeHandWithCommunity returns the equity of each player
+// given the community cards that are already dealt.
+func EvaluateEquityByMadeHandWithCommunity(players []Player, community []Card, opts ...Option) ([]float64, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, community); err != nil {
+		return nil, err
 	}
-
-	for _, c := range community {
-		deck.RemoveCard(c)
+	deck, err := o.remainingDeck(append(playersCards(players), community...))
+	if err != nil {
+		return nil, err
 	}
 
+	compare := o.compare(compareHandsByMadeHand)
 	shares := make([]float64, len(players))
 	var total int
+	full := make([]Card, 0, 5)
 	for _, board := range AllCombinations(deck.Cards, 5-len(community)) {
-		winners, err := CompareHandsByMadeHand(players, append(community, board...))
+		full = append(append(full[:0], community...), board...)
+		winners, err := compare(players, full)
 		if err != nil {
 			return nil, err
 		}
@@ -72,7 +70,15 @@ func EvaluateEquityByMadeHandWithCommunity(players []Player, community []Card) (
 }
 
 // CompareHandsByMadeHand returns the winner(s) of the game.
-func CompareHandsByMadeHand(players []Player, board []Card) ([]Player, error) {
+func CompareHandsByMadeHand(players []Player, board []Card, opts ...Option) ([]Player, error) {
+	o := newOptions(opts)
+	if err := o.validateDeal(players, board); err != nil {
+		return nil, err
+	}
+	return o.compare(compareHandsByMadeHand)(players, board)
+}
+
+func compareHandsByMadeHand(players []Player, board []Card) ([]Player, error) {
 	type maxPlayer struct {
 		player    Player
 		hand      []Card
@@ -292,13 +292,28 @@ func TestEvaluateEquityByMadeHandWithCommunity(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := poker.EvaluateEquityByMadeHandWithCommunity(tt.input.players, tt.input.community)
+			// Spare capacity must not be written to.
+			community := append(make([]poker.Card, 0, 5), tt.input.community...)
+			got, err := poker.EvaluateEquityByMadeHandWithCommunity(tt.input.players, community)
 			if err != nil {
 				t.Errorf("unexpected error: %s", err)
 			}
+			if len(community) < 5 && community[:5][len(community)] != (poker.Card{}) {
+				t.Errorf("community was written past its length: %s", community[:5][len(community)])
+			}
 			if diff := cmp.Diff(tt.want, got); diff != "" {
 				t.Errorf("mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestNewBestMadeHand_NoAllocs(t *testing.T) {
+	cards := poker.MustParseCards("Ah Kh 9h 7s 4h 3d 2c")
+	allocs := testing.AllocsPerRun(100, func() {
+		poker.NewBestMadeHand(cards)
+	})
+	if allocs != 0 {
+		t.Errorf("NewBestMadeHand allocated %v times per run, want 0", allocs)
+	}
+}
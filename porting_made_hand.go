@@ -16,17 +16,33 @@ func NewMadeHandFromIndex(value int) *MadeHand {
 	}
 }
 
+// NewBestMadeHand returns the best made hand of cards. It does not allocate
+// unless the returned MadeHand escapes.
+// If a card has an unknown rank or an out-of-range suit, or cards repeat so that
+// no hand can be made, the hand has Value 0, its Type is HandTypeUnknown and its
+// Rank and Power are 0.
 func NewBestMadeHand(cards []Card) *MadeHand {
-	flushSuit := findFlushSuit(cards)
+	return NewMadeHandFromIndex(bestMadeHandValue(cards))
+}
 
-	if flushSuit != nil {
-		return NewMadeHandFromIndex(asFlush[hashForFlush(cards, *flushSuit)])
+func bestMadeHandValue(cards []Card) int {
+	for _, card := range cards {
+		if !isNaturalCard(card) {
+			return 0
+		}
 	}
-
-	return NewMadeHandFromIndex(asRainbow[hashForRainbow(cards)])
+	if flushSuit, ok := findFlushSuit(cards); ok {
+		return asFlush[hashForFlush(cards, flushSuit)]
+	}
+	return asRainbow[hashForRainbow(cards)]
 }
 
+// Type returns the category of the hand, or HandTypeUnknown if Value is not
+// between 1 and NumHandClasses.
 func (hand *MadeHand) Type() HandType {
+	if hand.Value < 1 || hand.Value > NumHandClasses {
+		return HandTypeUnknown
+	}
 	if hand.Value > 6185 {
 		return HandTypeHighCard
 	}
@@ -60,7 +76,12 @@ func (hand *MadeHand) Type() HandType {
 
 }
 
+// Power returns the strength of the hand, higher is stronger, or 0 if Value is not
+// between 1 and NumHandClasses.
 func (hand *MadeHand) Power() int {
+	if hand.Value < 1 || hand.Value > NumHandClasses {
+		return 0
+	}
 	return 7462 - hand.Value
 }
 
@@ -68,47 +89,31 @@ func (hand *MadeHand) String() string {
 	return fmt.Sprintf("MadeHand<%d>", hand.Value)
 }
 
-func findFlushSuit(cards []Card) *Suit {
-	flush := make(map[Suit]int, 0)
-
+func findFlushSuit(cards []Card) (Suit, bool) {
+	var counts [Spades + 1]int
 	for _, card := range cards {
-		suit, ok := flush[card.Suit]
-		if !ok {
-			flush[card.Suit] = 1
+		if card.Suit < Hearts || card.Suit > Spades {
 			continue
 		}
-		flush[card.Suit] = suit + 1
+		counts[card.Suit]++
 	}
 
-	for suit, count := range flush {
+	for suit, count := range counts {
 		if count >= 5 {
-			return &suit
+			return Suit(suit), true
 		}
 	}
-	return nil
+	return 0, false
 }
 
+// hashForFlush returns the ranks of the flush suit as a bitmask:
+// 0x1 for a deuce up to 0x1000 for an ace.
 func hashForFlush(cards []Card, flushSuit Suit) int {
-	bitEachRank := map[Rank]int{
-		RankAce:   0x1000,
-		RankDeuce: 0x1,
-		RankThree: 0x2,
-		RankFour:  0x4,
-		RankFive:  0x8,
-		RankSix:   0x10,
-		RankSeven: 0x20,
-		RankEight: 0x40,
-		RankNine:  0x80,
-		RankTen:   0x100,
-		RankJack:  0x200,
-		RankQueen: 0x400,
-		RankKing:  0x800,
-	}
 	var hash int
 
 	for _, card := range cards {
 		if card.Suit == flushSuit {
-			hash += bitEachRank[card.Rank]
+			hash |= 1 << (card.Rank - RankDeuce)
 		}
 	}
 
@@ -116,21 +121,7 @@ func hashForFlush(cards []Card, flushSuit Suit) int {
 }
 
 func hashForRainbow(cards []Card) int {
-	cardLengthEachRank := map[Rank]int{
-		RankDeuce: 0,
-		RankThree: 0,
-		RankFour:  0,
-		RankFive:  0,
-		RankSix:   0,
-		RankSeven: 0,
-		RankEight: 0,
-		RankNine:  0,
-		RankTen:   0,
-		RankJack:  0,
-		RankQueen: 0,
-		RankKing:  0,
-		RankAce:   0,
-	}
+	var cardLengthEachRank [RankAce + 1]int
 	remainingCardLength := len(cards)
 
 	for _, card := range cards {
@@ -139,21 +130,7 @@ func hashForRainbow(cards []Card) int {
 
 	hash := 0
 
-	for _, rank := range []Rank{
-		RankDeuce,
-		RankThree,
-		RankFour,
-		RankFive,
-		RankSix,
-		RankSeven,
-		RankEight,
-		RankNine,
-		RankTen,
-		RankJack,
-		RankQueen,
-		RankKing,
-		RankAce,
-	} {
+	for rank := RankDeuce; rank <= RankAce; rank++ {
 		length := cardLengthEachRank[rank]
 
 		if length == 0 {
@@ -2,7 +2,9 @@ package poker
 
 // precalculated_table.go is porting from github.com/axross/poker
 
-var dpReference = map[int]map[Rank][]int{
+// dpReference is indexed by the number of cards of a rank, the rank and the
+// number of cards not yet hashed.
+var dpReference = [5][RankAce + 1][10]int{
 	1: {
 		RankAce:   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 		RankKing:  {1, 1, 1, 1, 1, 0, 0, 0, 0, 0},
@@ -0,0 +1,147 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// ShortDeckRules is the rule set of short-deck (6+) hold'em, played with the 36 cards
+// of NewDeck(WithShortDeck()). A flush always beats a full house and A-6-7-8-9 is the
+// lowest straight.
+type ShortDeckRules struct {
+	// TripsBeatStraight ranks three of a kind above a straight, as in most short-deck games.
+	// Otherwise a straight beats three of a kind as in hold'em.
+	TripsBeatStraight bool
+}
+
+// shortDeckOrders are the categories from the weakest to the strongest,
+// without and with TripsBeatStraight.
+var shortDeckOrders = [2][]HandType{
+	{
+		HandTypeHighCard, HandTypePair, HandTypeTwoPair, HandTypeThreeOfAKind, HandTypeStraight,
+		HandTypeFullHouse, HandTypeFlush, HandTypeFourOfAKind, HandTypeStraightFlush, HandTypeRoyalFlush,
+	},
+	{
+		HandTypeHighCard, HandTypePair, HandTypeTwoPair, HandTypeStraight, HandTypeThreeOfAKind,
+		HandTypeFullHouse, HandTypeFlush, HandTypeFourOfAKind, HandTypeStraightFlush, HandTypeRoyalFlush,
+	},
+}
+
+// shortDeckTripsFlag marks a ShortDeckRank ranked with TripsBeatStraight.
+// Ranks of the same rule set share it, so it does not change their order.
+const shortDeckTripsFlag = 1 << 24
+
+func (rules ShortDeckRules) order() []HandType {
+	if rules.TripsBeatStraight {
+		return shortDeckOrders[1]
+	}
+	return shortDeckOrders[0]
+}
+
+// ShortDeckRank is the strength of a short-deck hand. It is laid out like HandRank
+// with the categories reordered by the rule set, so a greater ShortDeckRank is a stronger
+// hand only when both were ranked under the same rules.
+type ShortDeckRank uint32
+
+// NewShortDeckRank returns the ShortDeckRank of category under rules with the given ranks
+// from the most significant, as in NewHandRank.
+func NewShortDeckRank(rules ShortDeckRules, category HandType, ranks ...Rank) ShortDeckRank {
+	r := NewHandRank(category, ranks...)
+	kickers := uint32(r) & (1<<handRankCategoryShift - 1)
+	strength := slices.Index(rules.order(), category) + 1
+	rank := uint32(strength)<<handRankCategoryShift | kickers
+	if rules.TripsBeatStraight {
+		rank |= shortDeckTripsFlag
+	}
+	return ShortDeckRank(rank)
+}
+
+// Rules returns the rule set the hand was ranked under.
+func (r ShortDeckRank) Rules() ShortDeckRules {
+	return ShortDeckRules{TripsBeatStraight: r&shortDeckTripsFlag != 0}
+}
+
+// HandRank returns the hand with the same category and ranks as a HandRank.
+// It describes the hand, but does not order short-deck hands correctly.
+func (r ShortDeckRank) HandRank() HandRank {
+	kickers := uint32(r) & (1<<handRankCategoryShift - 1)
+	return HandRank(uint32(r.Category())<<handRankCategoryShift | kickers)
+}
+
+// Category returns the HandType of the hand.
+func (r ShortDeckRank) Category() HandType {
+	strength := int(uint32(r)&^shortDeckTripsFlag) >> handRankCategoryShift
+	order := r.Rules().order()
+	if strength < 1 || strength > len(order) {
+		return HandTypeUnknown
+	}
+	return order[strength-1]
+}
+
+// Kickers returns the ranks of the hand from the most significant, as in HandRank.Kickers.
+func (r ShortDeckRank) Kickers() []Rank {
+	return r.HandRank().Kickers()
+}
+
+// Compare returns 1 if r is stronger than o, -1 if r is weaker, and 0 if they tie.
+func (r ShortDeckRank) Compare(o ShortDeckRank) int {
+	return cmp.Compare(r, o)
+}
+
+// Less reports whether r is weaker than o.
+func (r ShortDeckRank) Less(o ShortDeckRank) bool {
+	return r < o
+}
+
+func (r ShortDeckRank) String() string {
+	return r.Describe()
+}
+
+// Describe returns the hand in words like HandRank.Describe, e.g. "Straight, Nine high".
+func (r ShortDeckRank) Describe() string {
+	return r.HandRank().Describe()
+}
+
+// DescribeShort returns the hand in words like HandRank.DescribeShort.
+func (r ShortDeckRank) DescribeShort() string {
+	return r.HandRank().DescribeShort()
+}
+
+// EvaluateShortDeck returns the best short-deck hand under rules and its five cards
+// for 5 to 7 cards of a short deck. The cards are ordered like the ranks of the hand,
+// e.g. 9 8 7 6 A for the lowest straight.
+func EvaluateShortDeck(cards []Card, rules ShortDeckRules) (ShortDeckRank, []Card, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be 5 to 7, got %d", len(cards))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
+	}
+	for _, c := range cards {
+		if c.Rank < RankSix || c.Rank > RankAce {
+			return 0, nil, fmt.Errorf("card %s is not in a short deck", c)
+		}
+	}
+
+	var best ShortDeckRank
+	var bestFive [5]Card
+	forEachFive(cards, func(five [5]Card) {
+		high := fiveCardRank(&five, RankNine)
+		if rank := NewShortDeckRank(rules, high.Category(), high.Kickers()...); rank > best {
+			best, bestFive = rank, five
+		}
+	})
+	return best, bestFive[:], nil
+}
+
+// ShortDeckEvaluator ranks short-deck hands like EvaluateShortDeck, as ShortDeckRank values.
+type ShortDeckEvaluator struct {
+	Rules ShortDeckRules
+}
+
+func (e ShortDeckEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateShortDeck(cards, e.Rules)
+	return HandRank(rank), err
+}
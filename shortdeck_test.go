@@ -0,0 +1,201 @@
+package poker_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluateShortDeck(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    string
+		wantType poker.HandType
+		wantFive string
+		wantDesc string
+	}{
+		{
+			name:     "ace plays low in A-6-7-8-9",
+			cards:    "Ah 6c 7d 8s 9h Kc Kd",
+			wantType: poker.HandTypeStraight,
+			wantFive: "9h 8s 7d 6c Ah",
+			wantDesc: "Straight, Nine high",
+		},
+		{
+			name:     "lowest straight flush",
+			cards:    "9h 8h 7h 6h Ah As Ad",
+			wantType: poker.HandTypeStraightFlush,
+			wantFive: "9h 8h 7h 6h Ah",
+			wantDesc: "Straight Flush, Nine high",
+		},
+		{
+			name:     "flush",
+			cards:    "Ah Qh 9h 7h 6h 6c Ac",
+			wantType: poker.HandTypeFlush,
+			wantFive: "Ah Qh 9h 7h 6h",
+			wantDesc: "Flush, Ace-Queen-Nine-Seven-Six high",
+		},
+		{
+			name:     "full house",
+			cards:    "Ks Kh Kd 6c 6d",
+			wantType: poker.HandTypeFullHouse,
+			wantFive: "Ks Kh Kd 6c 6d",
+			wantDesc: "Full House, Kings full of Sixes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, five, err := poker.EvaluateShortDeck(poker.MustParseCards(tt.cards), poker.ShortDeckRules{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rank.Category() != tt.wantType {
+				t.Errorf("Category() = %s, want %s", rank.Category(), tt.wantType)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantFive), five); diff != "" {
+				t.Errorf("five cards mismatch (-want +got):\n%s", diff)
+			}
+			if got := rank.Describe(); got != tt.wantDesc {
+				t.Errorf("Describe() = %q, want %q", got, tt.wantDesc)
+			}
+		})
+	}
+}
+
+func TestEvaluateShortDeck_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards string
+	}{
+		{name: "four cards", cards: "Ah 6c 7d 8s"},
+		{name: "card below six", cards: "Ah 5c 7d 8s 9h"},
+		{name: "joker", cards: "Ah Xh 7d 8s 9h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := poker.EvaluateShortDeck(poker.MustParseCards(tt.cards), poker.ShortDeckRules{}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestShortDeckRank_Order(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules poker.ShortDeckRules
+		// order is from the weakest to the strongest.
+		order []poker.HandType
+	}{
+		{
+			name:  "straight beats trips",
+			rules: poker.ShortDeckRules{},
+			order: []poker.HandType{
+				poker.HandTypeHighCard, poker.HandTypePair, poker.HandTypeTwoPair, poker.HandTypeThreeOfAKind,
+				poker.HandTypeStraight, poker.HandTypeFullHouse, poker.HandTypeFlush, poker.HandTypeFourOfAKind,
+				poker.HandTypeStraightFlush, poker.HandTypeRoyalFlush,
+			},
+		},
+		{
+			name:  "trips beat straight",
+			rules: poker.ShortDeckRules{TripsBeatStraight: true},
+			order: []poker.HandType{
+				poker.HandTypeHighCard, poker.HandTypePair, poker.HandTypeTwoPair, poker.HandTypeStraight,
+				poker.HandTypeThreeOfAKind, poker.HandTypeFullHouse, poker.HandTypeFlush, poker.HandTypeFourOfAKind,
+				poker.HandTypeStraightFlush, poker.HandTypeRoyalFlush,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prev poker.ShortDeckRank
+			for _, category := range tt.order {
+				// The strongest kickers of a category stay below the weakest of the next one.
+				weakest := poker.NewShortDeckRank(tt.rules, category, poker.RankSix)
+				strongest := poker.NewShortDeckRank(tt.rules, category, poker.RankAce, poker.RankAce, poker.RankAce, poker.RankAce, poker.RankAce)
+				if !prev.Less(weakest) {
+					t.Errorf("%s is not stronger than %s", weakest, prev)
+				}
+				if got := strongest.Category(); got != category {
+					t.Errorf("Category() = %s, want %s", got, category)
+				}
+				if got := strongest.Rules(); got != tt.rules {
+					t.Errorf("Rules() = %+v, want %+v", got, tt.rules)
+				}
+				prev = strongest
+			}
+		})
+	}
+}
+
+func TestShortDeckEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "flush", Hand: poker.MustParseCards("Ah 7h")},
+		{Name: "full house", Hand: poker.MustParseCards("9d 6c")},
+	}
+	board := poker.MustParseCards("Kh Qh 9h 9c 6s")
+
+	winners, err := poker.CompareHands(players, board, poker.WithEvaluator(poker.ShortDeckEvaluator{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"flush"}, playerNames(winners)); diff != "" {
+		t.Errorf("short deck winners mismatch (-want +got):\n%s", diff)
+	}
+	winners, err = poker.CompareHands(players, board)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"full house"}, playerNames(winners)); diff != "" {
+		t.Errorf("hold'em winners mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := (poker.ShortDeckEvaluator{}).Evaluate(poker.MustParseCards("Ah 2h Kh Qh Jh")); err == nil {
+		t.Error("expected error for a deuce")
+	}
+}
+
+func TestShortDeckEvaluator_Equity(t *testing.T) {
+	players := []poker.Player{
+		{Name: "player1", Hand: poker.MustParseCards("Kh Qd")},
+		{Name: "player2", Hand: poker.MustParseCards("Ah Ad")},
+	}
+	community := poker.MustParseCards("Js Ts 6c 7c")
+
+	tests := []struct {
+		name  string
+		rules poker.ShortDeckRules
+		want  []float64
+	}{
+		{
+			// Player1 makes a straight with the four nines and the two remaining aces.
+			name:  "straight beats trips",
+			rules: poker.ShortDeckRules{},
+			want:  []float64{6.0 / 28, 22.0 / 28},
+		},
+		{
+			// An ace gives player2 trips, which now beat the Broadway of player1.
+			name:  "trips beat straight",
+			rules: poker.ShortDeckRules{TripsBeatStraight: true},
+			want:  []float64{4.0 / 28, 24.0 / 28},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := poker.EvaluateEquityByMadeHandWithCommunity(players, community,
+				poker.WithDeck(poker.NewDeck(poker.WithShortDeck())),
+				poker.WithEvaluator(poker.ShortDeckEvaluator{Rules: tt.rules}),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("equity mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package poker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// StudVariant is a seven-card stud game. It decides who brings in and who acts first.
+type StudVariant int
+
+const (
+	// StudHigh is seven-card stud, won by the best high hand.
+	StudHigh StudVariant = iota
+	// StudEightOrBetter is stud hi-lo split with an eight-or-better low.
+	StudEightOrBetter
+	// Razz is stud won by the best ace-to-five low.
+	Razz
+)
+
+func (v StudVariant) String() string {
+	switch v {
+	case StudHigh:
+		return "Stud"
+	case StudEightOrBetter:
+		return "Stud-8"
+	case Razz:
+		return "Razz"
+	default:
+		return "unknown"
+	}
+}
+
+// StudStreet is a betting round of seven-card stud, named after the number of cards
+// each player holds once it is dealt.
+type StudStreet int
+
+const (
+	ThirdStreet StudStreet = iota + 3
+	FourthStreet
+	FifthStreet
+	SixthStreet
+	SeventhStreet
+)
+
+func (s StudStreet) String() string {
+	switch s {
+	case ThirdStreet:
+		return "third street"
+	case FourthStreet:
+		return "fourth street"
+	case FifthStreet:
+		return "fifth street"
+	case SixthStreet:
+		return "sixth street"
+	case SeventhStreet:
+		return "seventh street"
+	default:
+		return "unknown"
+	}
+}
+
+// StudCard is a card of a stud hand and whether it was dealt face up.
+type StudCard struct {
+	Card
+	Up bool
+}
+
+// StudHand is the cards of a stud player in the order they were dealt: two down cards
+// and the door card on third street, one up card on each of fourth to sixth street
+// and a down card on seventh street. Seat is the seat of the player at the table,
+// which stays the same when other players fold.
+type StudHand struct {
+	Name  string
+	Seat  int
+	Cards []StudCard
+}
+
+// UpCards returns the face-up cards in the order they were dealt.
+func (h StudHand) UpCards() []Card {
+	return h.cards(true)
+}
+
+// DownCards returns the face-down cards in the order they were dealt.
+func (h StudHand) DownCards() []Card {
+	return h.cards(false)
+}
+
+func (h StudHand) cards(up bool) []Card {
+	var cards []Card
+	for _, c := range h.Cards {
+		if c.Up == up {
+			cards = append(cards, c.Card)
+		}
+	}
+	return cards
+}
+
+// DoorCard returns the first face-up card, or false if none has been dealt.
+func (h StudHand) DoorCard() (Card, bool) {
+	for _, c := range h.Cards {
+		if c.Up {
+			return c.Card, true
+		}
+	}
+	return Card{}, false
+}
+
+// Player returns the player holding every card of the hand, for the showdown with
+// CompareHands for Stud, CompareHandsHiLo for Stud-8, or CompareHands with
+// WithEvaluator(AceToFiveEvaluator) for Razz, each with a nil board.
+func (h StudHand) Player() Player {
+	cards := make([]Card, 0, len(h.Cards))
+	for _, c := range h.Cards {
+		cards = append(cards, c.Card)
+	}
+	return Player{Name: h.Name, Hand: cards}
+}
+
+// StudPlayers returns the Player of each hand.
+func StudPlayers(hands []StudHand) []Player {
+	players := make([]Player, 0, len(hands))
+	for _, h := range hands {
+		players = append(players, h.Player())
+	}
+	return players
+}
+
+// DealStudStreet deals street to every hand in rotation from the dealer's left and
+// records the cards as dealt to the Seat of each hand, so that Dealer.SeatCards returns
+// the cards of a hand even after others have folded. Each hand must hold the cards
+// of the previous streets and have its own seat. Third street deals two down cards
+// and the door card, fourth to sixth street an up card, and seventh street a down card.
+// Nothing is dealt if the deck runs short, e.g. with eight players on seventh street.
+func (dl *Dealer) DealStudStreet(hands []StudHand, street StudStreet) error {
+	if street < ThirdStreet || street > SeventhStreet {
+		return fmt.Errorf("invalid street: %d", int(street))
+	}
+	n := 1
+	if street == ThirdStreet {
+		n = 3
+	}
+	if len(hands) == 0 {
+		return fmt.Errorf("no hands")
+	}
+	seats := make([]int, 0, len(hands))
+	for _, h := range hands {
+		if len(h.Cards) != int(street)-n {
+			return fmt.Errorf("%s holds %d cards before %s, want %d", h.Name, len(h.Cards), street, int(street)-n)
+		}
+		if h.Seat < 0 || slices.Contains(seats, h.Seat) {
+			return fmt.Errorf("invalid seat for %s: %d", h.Name, h.Seat)
+		}
+		seats = append(seats, h.Seat)
+	}
+
+	dealt, err := dl.dealRotation(seats, n)
+	if err != nil {
+		return fmt.Errorf("failed to deal %s: %w", street, err)
+	}
+	for i, cards := range dealt {
+		for j, c := range cards {
+			up := street != SeventhStreet && (street != ThirdStreet || j == 2)
+			hands[i].Cards = append(hands[i].Cards, StudCard{Card: c, Up: up})
+		}
+	}
+	return nil
+}
+
+// bridgeSuitOrder ranks the suits from the lowest for the bring-in: clubs, diamonds, hearts, spades.
+func bridgeSuitOrder(s Suit) int {
+	switch s {
+	case Clubs:
+		return 0
+	case Diamonds:
+		return 1
+	case Hearts:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// BringIn returns the index of the hand that must bring in on third street, given the hands
+// still in play from the dealer's left. In Stud and Stud-8 it is the lowest door card with
+// aces high, and in Razz the highest door card with aces low. Ties on rank are broken by suit
+// in bridge order, from the lowest: clubs, diamonds, hearts and spades. The lowest suit brings
+// in for Stud and Stud-8 and the highest for Razz.
+func BringIn(variant StudVariant, hands []StudHand) (int, error) {
+	if len(hands) == 0 {
+		return 0, fmt.Errorf("no hands")
+	}
+	doors := make([]Card, 0, len(hands))
+	for _, h := range hands {
+		door, ok := h.DoorCard()
+		if !ok {
+			return 0, fmt.Errorf("%s has no door card", h.Name)
+		}
+		doors = append(doors, door)
+	}
+
+	// weaker returns a positive number if door card a brings in rather than b.
+	weaker := func(a, b Card) int {
+		return cmp.Or(cmp.Compare(b.Rank, a.Rank), cmp.Compare(bridgeSuitOrder(b.Suit), bridgeSuitOrder(a.Suit)))
+	}
+	if variant == Razz {
+		weaker = func(a, b Card) int {
+			return cmp.Or(cmp.Compare(aceLowValue(a.Rank), aceLowValue(b.Rank)), cmp.Compare(bridgeSuitOrder(a.Suit), bridgeSuitOrder(b.Suit)))
+		}
+	}
+
+	bringIn := 0
+	for i, door := range doors {
+		if weaker(door, doors[bringIn]) > 0 {
+			bringIn = i
+		}
+	}
+	return bringIn, nil
+}
+
+// FirstToAct returns the index of the hand that acts first, given the hands still in play
+// from the dealer's left. On third street the bring-in acts first. On later streets it is
+// the best hand showing: the highest VisibleRank in Stud and Stud-8, and the lowest
+// VisibleLowRank in Razz. Of equal hands showing, the one closest to the dealer's left acts first.
+func FirstToAct(variant StudVariant, hands []StudHand) (int, error) {
+	if len(hands) == 0 {
+		return 0, fmt.Errorf("no hands")
+	}
+	up := len(hands[0].UpCards())
+	for _, h := range hands[1:] {
+		if n := len(h.UpCards()); n != up {
+			return 0, fmt.Errorf("%s shows %d up cards, want %d as %s", h.Name, n, up, hands[0].Name)
+		}
+	}
+	if up <= 1 {
+		return BringIn(variant, hands)
+	}
+
+	showing := func(h StudHand) (uint32, error) {
+		r, err := h.VisibleRank()
+		return uint32(r), err
+	}
+	if variant == Razz {
+		showing = func(h StudHand) (uint32, error) {
+			r, err := h.VisibleLowRank()
+			return uint32(r), err
+		}
+	}
+
+	first := 0
+	var best uint32
+	for i, h := range hands {
+		rank, err := showing(h)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 || rank > best {
+			first, best = i, rank
+		}
+	}
+	return first, nil
+}
+
+// VisibleRank returns the high hand showing on the 1 to 4 up cards. Only pairs, two pair,
+// trips and quads count, so straights and flushes are ranked by their high cards.
+func (h StudHand) VisibleRank() (HandRank, error) {
+	category, ranks, err := visibleHand(h, func(r Rank) int { return int(r) })
+	if err != nil {
+		return 0, err
+	}
+	return NewHandRank(category, ranks...), nil
+}
+
+// VisibleLowRank returns the ace-to-five low showing on the 1 to 4 up cards.
+func (h StudHand) VisibleLowRank() (LowRank, error) {
+	category, ranks, err := visibleHand(h, aceLowValue)
+	if err != nil {
+		return 0, err
+	}
+	return NewLowRank(category, ranks...), nil
+}
+
+// visibleHand returns the HandType and the ranks of the up cards of h, ignoring straights
+// and flushes, with ranks ordered by count and then by value.
+func visibleHand(h StudHand, value func(Rank) int) (HandType, []Rank, error) {
+	up := h.UpCards()
+	if len(up) < 1 || len(up) > 4 {
+		return 0, nil, fmt.Errorf("invalid number of up cards for %s: must be 1 to 4, got %d", h.Name, len(up))
+	}
+	var v cardValidator
+	if err := v.add(OwnerHand, up...); err != nil {
+		return 0, nil, err
+	}
+
+	var counts [RankAce + 1]int
+	for _, c := range up {
+		counts[c.Rank]++
+	}
+	slices.SortFunc(up, func(a, b Card) int {
+		return cmp.Or(cmp.Compare(counts[b.Rank], counts[a.Rank]), cmp.Compare(value(b.Rank), value(a.Rank)))
+	})
+	ranks := make([]Rank, 0, len(up))
+	for i, c := range up {
+		if i == 0 || c.Rank != up[i-1].Rank {
+			ranks = append(ranks, c.Rank)
+		}
+	}
+	// Count the hand as if it were padded to five cards with distinct unpaired ranks,
+	// so that e.g. trips with a kicker are not mistaken for a full house.
+	return pairedHandType(counts[up[0].Rank], len(ranks)+5-len(up)), ranks, nil
+}
@@ -0,0 +1,272 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+// studHand returns a hand dealt the first two down cards, then the up cards,
+// then the remaining down cards.
+func studHand(name, down, up string) poker.StudHand {
+	h := poker.StudHand{Name: name}
+	downCards := poker.MustParseCards(down)
+	for i, c := range downCards {
+		if i == 2 {
+			break
+		}
+		h.Cards = append(h.Cards, poker.StudCard{Card: c})
+	}
+	for _, c := range poker.MustParseCards(up) {
+		h.Cards = append(h.Cards, poker.StudCard{Card: c, Up: true})
+	}
+	for _, c := range downCards[min(2, len(downCards)):] {
+		h.Cards = append(h.Cards, poker.StudCard{Card: c})
+	}
+	return h
+}
+
+func TestDealer_DealStudStreet(t *testing.T) {
+	dl := poker.NewDealer(poker.NewDeck())
+	hands := []poker.StudHand{{Name: "p0", Seat: 0}, {Name: "p1", Seat: 1}, {Name: "p2", Seat: 2}}
+
+	if err := dl.DealStudStreet(hands, poker.FourthStreet); err == nil {
+		t.Error("expected error for fourth street before third street")
+	}
+	for street := poker.ThirdStreet; street <= poker.SeventhStreet; street++ {
+		if err := dl.DealStudStreet(hands, street); err != nil {
+			t.Fatalf("unexpected error on %s: %s", street, err)
+		}
+	}
+
+	for i, h := range hands {
+		var up []bool
+		for _, c := range h.Cards {
+			up = append(up, c.Up)
+		}
+		if diff := cmp.Diff([]bool{false, false, true, true, true, true, false}, up); diff != "" {
+			t.Errorf("%s up cards mismatch (-want +got):\n%s", h.Name, diff)
+		}
+		if diff := cmp.Diff(dl.SeatCards(i), h.Player().Hand); diff != "" {
+			t.Errorf("%s cards mismatch with seat (-want +got):\n%s", h.Name, diff)
+		}
+	}
+	if got := len(dl.Deck().Cards); got != 52-21 {
+		t.Errorf("deck has %d cards, want %d", got, 52-21)
+	}
+	if err := dl.DealStudStreet(hands, poker.SeventhStreet); err == nil {
+		t.Error("expected error for dealing seventh street twice")
+	}
+
+	if err := dl.DealStudStreet([]poker.StudHand{{Name: "p0"}, {Name: "p1"}}, poker.ThirdStreet); err == nil {
+		t.Error("expected error for hands in the same seat")
+	}
+}
+
+func TestDealer_DealStudStreet_Fold(t *testing.T) {
+	dl := poker.NewDealer(poker.NewDeck())
+	hands := []poker.StudHand{{Name: "p0", Seat: 0}, {Name: "p1", Seat: 1}, {Name: "p2", Seat: 2}}
+	if err := dl.DealStudStreet(hands, poker.ThirdStreet); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// p1 folds, so p2 is now the second hand in play.
+	hands = []poker.StudHand{hands[0], hands[2]}
+	for street := poker.FourthStreet; street <= poker.SeventhStreet; street++ {
+		if err := dl.DealStudStreet(hands, street); err != nil {
+			t.Fatalf("unexpected error on %s: %s", street, err)
+		}
+	}
+
+	for _, h := range hands {
+		if diff := cmp.Diff(dl.SeatCards(h.Seat), h.Player().Hand); diff != "" {
+			t.Errorf("%s cards mismatch with seat (-want +got):\n%s", h.Name, diff)
+		}
+	}
+	if got := len(dl.SeatCards(1)); got != 3 {
+		t.Errorf("folded seat has %d cards, want 3", got)
+	}
+}
+
+func TestDealer_DealStudStreet_NotEnoughCards(t *testing.T) {
+	dl := poker.NewDealer(poker.NewDeck())
+	hands := make([]poker.StudHand, 8)
+	for i := range hands {
+		hands[i].Seat = i
+	}
+	for street := poker.ThirdStreet; street < poker.SeventhStreet; street++ {
+		if err := dl.DealStudStreet(hands, street); err != nil {
+			t.Fatalf("unexpected error on %s: %s", street, err)
+		}
+	}
+	if err := dl.DealStudStreet(hands, poker.SeventhStreet); !errors.Is(err, poker.ErrNotEnoughCards) {
+		t.Errorf("DealStudStreet() error = %v, want %v", err, poker.ErrNotEnoughCards)
+	}
+	if got := len(hands[0].Cards); got != 6 {
+		t.Errorf("hand has %d cards, want 6", got)
+	}
+}
+
+func TestBringIn(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant poker.StudVariant
+		doors   []string
+		want    int
+	}{
+		{name: "lowest suit brings in", variant: poker.StudHigh, doors: []string{"Ks", "2d", "2c"}, want: 2},
+		{name: "aces are high", variant: poker.StudHigh, doors: []string{"Ah", "3s", "9d"}, want: 1},
+		{name: "stud-8 like stud", variant: poker.StudEightOrBetter, doors: []string{"5h", "5s", "9c"}, want: 0},
+		{name: "highest suit brings in for razz", variant: poker.Razz, doors: []string{"Kc", "Kd", "4s"}, want: 1},
+		{name: "aces are low for razz", variant: poker.Razz, doors: []string{"Ah", "7c", "3d"}, want: 1},
+	}
+
+	// Down cards that no door card uses.
+	downs := []string{"Tc Td", "Th Ts", "Jc Jd"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hands []poker.StudHand
+			for i, door := range tt.doors {
+				hands = append(hands, studHand("", downs[i], door))
+			}
+			got, err := poker.BringIn(tt.variant, hands)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("BringIn() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := poker.BringIn(poker.StudHigh, []poker.StudHand{studHand("p0", "Tc Td", "")}); err == nil {
+		t.Error("expected error for a hand without a door card")
+	}
+}
+
+func TestFirstToAct(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant poker.StudVariant
+		ups     []string
+		want    int
+	}{
+		{name: "bring-in on third street", variant: poker.StudHigh, ups: []string{"Ks", "2d", "2c"}, want: 2},
+		{name: "pair showing", variant: poker.StudHigh, ups: []string{"Kc 9d", "4s 4h", "Ac Qd"}, want: 1},
+		{name: "tie goes to the dealer's left", variant: poker.StudHigh, ups: []string{"Kc 9d", "Ks 9h"}, want: 0},
+		{name: "flush draw is only ace high", variant: poker.StudHigh, ups: []string{"7c 7d 2s", "Ah Kh Qh"}, want: 0},
+		{name: "stud-8 by high hand", variant: poker.StudEightOrBetter, ups: []string{"3c 2d", "Kc Qd"}, want: 1},
+		{name: "lowest low for razz", variant: poker.Razz, ups: []string{"5c 3d", "Ac 2d", "Kc Kd"}, want: 1},
+		{name: "pair is bad for razz", variant: poker.Razz, ups: []string{"Ah As", "Kc Qd"}, want: 1},
+	}
+
+	downs := []string{"Tc Td", "Th Ts", "Jc Jd"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hands []poker.StudHand
+			for i, up := range tt.ups {
+				hands = append(hands, studHand("", downs[i], up))
+			}
+			got, err := poker.FirstToAct(tt.variant, hands)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("FirstToAct() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	hands := []poker.StudHand{studHand("p0", downs[0], "Kc 9d"), studHand("p1", downs[1], "4s")}
+	if _, err := poker.FirstToAct(poker.StudHigh, hands); err == nil {
+		t.Error("expected error for hands on different streets")
+	}
+}
+
+func TestStudHand_VisibleRank(t *testing.T) {
+	tests := []struct {
+		up       string
+		want     poker.HandRank
+		wantLow  poker.LowRank
+		wantDesc string
+	}{
+		{
+			up:       "9c 9d 9h 2s",
+			want:     poker.NewHandRank(poker.HandTypeThreeOfAKind, poker.RankNine, poker.RankDeuce),
+			wantLow:  poker.NewLowRank(poker.HandTypeThreeOfAKind, poker.RankNine, poker.RankDeuce),
+			wantDesc: "Three of a Kind, Nines with Deuce kicker",
+		},
+		{
+			up:       "9c 2d 9h 2s",
+			want:     poker.NewHandRank(poker.HandTypeTwoPair, poker.RankNine, poker.RankDeuce),
+			wantLow:  poker.NewLowRank(poker.HandTypeTwoPair, poker.RankNine, poker.RankDeuce),
+			wantDesc: "Two Pair, Nines and Deuces",
+		},
+		{
+			up:       "Ah 5h 4h",
+			want:     poker.NewHandRank(poker.HandTypeHighCard, poker.RankAce, poker.RankFive, poker.RankFour),
+			wantLow:  poker.NewLowRank(poker.HandTypeHighCard, poker.RankFive, poker.RankFour, poker.RankAce),
+			wantDesc: "High Card, Ace-Five-Four high",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.up, func(t *testing.T) {
+			h := studHand("p0", "Tc Td", tt.up)
+			got, err := h.VisibleRank()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("VisibleRank() = %s, want %s", got, tt.want)
+			}
+			if got.Describe() != tt.wantDesc {
+				t.Errorf("Describe() = %q, want %q", got.Describe(), tt.wantDesc)
+			}
+			low, err := h.VisibleLowRank()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if low != tt.wantLow {
+				t.Errorf("VisibleLowRank() = %s, want %s", low, tt.wantLow)
+			}
+		})
+	}
+
+	if _, err := studHand("p0", "Tc Td", "").VisibleRank(); err == nil {
+		t.Error("expected error for no up cards")
+	}
+}
+
+func TestStudPlayers(t *testing.T) {
+	hands := []poker.StudHand{
+		studHand("wheel", "Ac 2d 9s", "3h 4s 5c Kd"),
+		studHand("seven", "7c 6d Kh", "4h 3s 2c Qd"),
+	}
+
+	winners, err := poker.CompareHands(poker.StudPlayers(hands), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"wheel"}, playerNames(winners)); diff != "" {
+		t.Errorf("stud winners mismatch (-want +got):\n%s", diff)
+	}
+
+	winners, err = poker.CompareHands(poker.StudPlayers(hands), nil, poker.WithEvaluator(poker.AceToFiveEvaluator))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"wheel"}, playerNames(winners)); diff != "" {
+		t.Errorf("razz winners mismatch (-want +got):\n%s", diff)
+	}
+
+	result, err := poker.CompareHandsHiLo(poker.StudPlayers(hands), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]float64{1, 0}, result.Shares); diff != "" {
+		t.Errorf("stud-8 shares mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,143 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Owners used in validation errors for cards that do not belong to a player.
+const (
+	OwnerBoard = "board"
+	OwnerDead  = "dead"
+	OwnerHand  = "hand"
+)
+
+// InvalidCardError is returned when a card has an unknown rank or an out-of-range suit.
+type InvalidCardError struct {
+	Card  Card
+	Owner string
+}
+
+func (e *InvalidCardError) Error() string {
+	return fmt.Sprintf("invalid card (rank: %d, suit: %d) in %s", int(e.Card.Rank), int(e.Card.Suit), e.Owner)
+}
+
+// CardConflictError is returned when the same card is held more than once,
+// e.g. by two players or by a player and the board.
+type CardConflictError struct {
+	Card   Card
+	Owners []string
+}
+
+func (e *CardConflictError) Error() string {
+	if len(e.Owners) == 2 && e.Owners[0] == e.Owners[1] {
+		return fmt.Sprintf("duplicate card %s in %s", e.Card.StringShort(), e.Owners[0])
+	}
+	return fmt.Sprintf("card %s is held by both %s", e.Card.StringShort(), strings.Join(e.Owners, " and "))
+}
+
+// CardNotInDeckError is returned when a card held by a player or on the board is not
+// in the deck to enumerate, e.g. a deuce with a short deck given by WithDeck.
+type CardNotInDeckError struct {
+	Card Card
+}
+
+func (e *CardNotInDeckError) Error() string {
+	return fmt.Sprintf("card %s is not in the deck", e.Card.StringShort())
+}
+
+// ValidateDeal checks that every card held by the players, on the board and in dead
+// is a valid natural card and that no card appears twice.
+func ValidateDeal(players []Player, board []Card, dead CardSet) error {
+	return validateDeal(players, board, dead, false)
+}
+
+// validateDeal is like ValidateDeal but also accepts jokers if jokers is true.
+func validateDeal(players []Player, board []Card, dead CardSet, jokers bool) error {
+	v := cardValidator{jokers: jokers}
+	for i, p := range players {
+		if err := v.add(playerOwner(p, i), p.Hand...); err != nil {
+			return err
+		}
+	}
+	if err := v.add(OwnerBoard, board...); err != nil {
+		return err
+	}
+	for c := range dead.All() {
+		if err := v.add(OwnerDead, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHand is like cardValidator.add with OwnerHand but does not
+// record owners, so it is cheap enough to run on every evaluation.
+func validateHand(cards []Card) error {
+	var seen CardSet
+	for _, c := range cards {
+		if err := addCard(&seen, c, false, OwnerHand); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isNaturalCard reports whether c has a rank from deuce to ace and one of the four suits.
+func isNaturalCard(c Card) bool {
+	return c.Rank >= RankDeuce && c.Rank <= RankAce && c.Suit >= Hearts && c.Suit <= Spades
+}
+
+// isValidCard reports whether c is a natural card, or RedJoker or BlackJoker if jokers is true.
+func isValidCard(c Card, jokers bool) bool {
+	return isNaturalCard(c) || (jokers && isJoker(c))
+}
+
+// addCard adds c to seen. It returns an *InvalidCardError naming owner if c is not valid,
+// or a *CardConflictError with owner as both owners if c is already in seen.
+func addCard(seen *CardSet, c Card, jokers bool, owner string) error {
+	if !isValidCard(c, jokers) {
+		return &InvalidCardError{Card: c, Owner: owner}
+	}
+	if seen.Contains(c) {
+		return &CardConflictError{Card: c, Owners: []string{owner, owner}}
+	}
+	*seen = seen.Add(c)
+	return nil
+}
+
+func playerOwner(p Player, i int) string {
+	if p.Name == "" {
+		return fmt.Sprintf("player %d", i)
+	}
+	return p.Name
+}
+
+// cardValidator records which owner holds each card it has seen.
+// owners is indexed by the bit position of the card in a CardSet.
+// Jokers are invalid unless jokers is true.
+type cardValidator struct {
+	seen   CardSet
+	owners [64]string
+	jokers bool
+}
+
+func (v *cardValidator) add(owner string, cards ...Card) error {
+	for _, c := range cards {
+		if err := addCard(&v.seen, c, v.jokers, owner); err != nil {
+			var conflict *CardConflictError
+			if errors.As(err, &conflict) {
+				conflict.Owners[0] = v.ownerOf(c)
+			}
+			return err
+		}
+		v.owners[bits.TrailingZeros64(uint64(cardBit(c)))] = owner
+	}
+	return nil
+}
+
+func (v *cardValidator) ownerOf(c Card) string {
+	return v.owners[bits.TrailingZeros64(uint64(cardBit(c)))]
+}
@@ -0,0 +1,152 @@
+package poker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/whywaita/poker-go"
+)
+
+func TestValidateDeal(t *testing.T) {
+	tests := []struct {
+		name         string
+		players      []poker.Player
+		board        []poker.Card
+		dead         poker.CardSet
+		wantConflict *poker.CardConflictError
+		wantInvalid  *poker.InvalidCardError
+	}{
+		{
+			name: "valid",
+			players: []poker.Player{
+				{Name: "alice", Hand: poker.MustParseCards("Ah Kh")},
+				{Name: "bob", Hand: poker.MustParseCards("Qs Qd")},
+			},
+			board: poker.MustParseCards("2c 7d 9s"),
+			dead:  poker.NewCardSet(poker.MustParseCards("3c")...),
+		},
+		{
+			name: "two players hold the same card",
+			players: []poker.Player{
+				{Name: "alice", Hand: poker.MustParseCards("Ah Kh")},
+				{Name: "bob", Hand: poker.MustParseCards("Ah Qd")},
+			},
+			wantConflict: &poker.CardConflictError{
+				Card:   poker.Card{Rank: poker.RankAce, Suit: poker.Hearts},
+				Owners: []string{"alice", "bob"},
+			},
+		},
+		{
+			name: "hole card on the board",
+			players: []poker.Player{
+				{Name: "alice", Hand: poker.MustParseCards("Ah Kh")},
+			},
+			board: poker.MustParseCards("2c Kh 9s"),
+			wantConflict: &poker.CardConflictError{
+				Card:   poker.Card{Rank: poker.RankKing, Suit: poker.Hearts},
+				Owners: []string{"alice", poker.OwnerBoard},
+			},
+		},
+		{
+			name: "dead card held by an unnamed player",
+			players: []poker.Player{
+				{Hand: poker.MustParseCards("Ah Kh")},
+			},
+			dead: poker.NewCardSet(poker.MustParseCards("Ah")...),
+			wantConflict: &poker.CardConflictError{
+				Card:   poker.Card{Rank: poker.RankAce, Suit: poker.Hearts},
+				Owners: []string{"player 0", poker.OwnerDead},
+			},
+		},
+		{
+			name: "unknown rank",
+			players: []poker.Player{
+				{Name: "alice", Hand: []poker.Card{{Rank: poker.RankUnknown, Suit: poker.Hearts}, {Rank: poker.RankKing, Suit: poker.Hearts}}},
+			},
+			wantInvalid: &poker.InvalidCardError{
+				Card:  poker.Card{Rank: poker.RankUnknown, Suit: poker.Hearts},
+				Owner: "alice",
+			},
+		},
+		{
+			name:  "out of range suit",
+			board: []poker.Card{{Rank: poker.RankKing, Suit: poker.Suit(4)}},
+			wantInvalid: &poker.InvalidCardError{
+				Card:  poker.Card{Rank: poker.RankKing, Suit: poker.Suit(4)},
+				Owner: poker.OwnerBoard,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := poker.ValidateDeal(tt.players, tt.board, tt.dead)
+			switch {
+			case tt.wantConflict != nil:
+				var got *poker.CardConflictError
+				if !errors.As(err, &got) {
+					t.Fatalf("expected *CardConflictError, got %v", err)
+				}
+				if diff := cmp.Diff(tt.wantConflict, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			case tt.wantInvalid != nil:
+				var got *poker.InvalidCardError
+				if !errors.As(err, &got) {
+					t.Fatalf("expected *InvalidCardError, got %v", err)
+				}
+				if diff := cmp.Diff(tt.wantInvalid, got); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			default:
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestEntryPointsRejectConflicts(t *testing.T) {
+	players := []poker.Player{
+		{Name: "alice", Hand: poker.MustParseCards("Ah Kh")},
+		{Name: "bob", Hand: poker.MustParseCards("Ah Qd")},
+	}
+	board := poker.MustParseCards("2c 7d 9s Ts Js")
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"CompareHands", func() error { _, err := poker.CompareHands(players, board); return err }},
+		{"CompareHandsByMadeHand", func() error { _, err := poker.CompareHandsByMadeHand(players, board); return err }},
+		{"EvaluateEquity", func() error { _, err := poker.EvaluateEquity(players); return err }},
+		{"EvaluateEquityByMadeHand", func() error { _, err := poker.EvaluateEquityByMadeHand(players); return err }},
+		{"EvaluateEquityByMadeHandWithCommunity", func() error {
+			_, err := poker.EvaluateEquityByMadeHandWithCommunity(players[:1], poker.MustParseCards("Kh 2c 3c"))
+			return err
+		}},
+		{"CalculateOuts", func() error {
+			_, err := poker.CalculateOuts(players[0].Hand, board[:3], [][]poker.Card{players[1].Hand})
+			return err
+		}},
+		{"CalculateOuts on the river", func() error {
+			_, err := poker.CalculateOuts(players[0].Hand, board, [][]poker.Card{players[1].Hand})
+			return err
+		}},
+		{"Evaluate", func() error {
+			_, _, err := poker.Evaluate(append(poker.MustParseCards("Ah Kh"), poker.MustParseCards("Ah 2c 3c 4c 5c")...))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conflict *poker.CardConflictError
+			if err := tt.call(); !errors.As(err, &conflict) {
+				t.Errorf("expected *CardConflictError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package poker
+
+import (
+	"fmt"
+	"slices"
+)
+
+// EvaluateWild returns the best hand of 5 to 7 cards in which every joker and every card
+// of wildRanks is wild, e.g. EvaluateWild(cards, RankDeuce) for deuces wild, and its five cards
+// ordered like the ranks of the hand with each wild card in the place it plays.
+//
+// A wild card plays as any card, even one that is already in the hand, so five of a kind
+// is possible and ranks above a royal flush. The one exception is a flush, which never
+// holds two cards of the same rank: a wild card in a flush plays as the highest rank
+// missing from its suit. Hands are ranked only by the ranks they play, so a hand made
+// with wild cards ties with the same natural hand.
+func EvaluateWild(cards []Card, wildRanks ...Rank) (HandRank, []Card, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return 0, nil, fmt.Errorf("invalid number of cards: must be 5 to 7, got %d", len(cards))
+	}
+	v := cardValidator{jokers: true}
+	if err := v.add(OwnerHand, cards...); err != nil {
+		return 0, nil, err
+	}
+
+	var w wildHand
+	for _, c := range cards {
+		if c.Rank == RankJoker || slices.Contains(wildRanks, c.Rank) {
+			w.wilds = append(w.wilds, c)
+			continue
+		}
+		w.naturals = append(w.naturals, c)
+		w.counts[c.Rank]++
+		w.suits[c.Suit] |= 1 << c.Rank
+		w.ranks |= 1 << c.Rank
+	}
+	rank, p := w.best()
+	return rank, w.cards(p), nil
+}
+
+// WildEvaluator ranks hands like EvaluateWild with jokers and the cards of Ranks wild,
+// e.g. WildEvaluator{Ranks: []Rank{RankDeuce}} for deuces wild. It accepts jokers, so the
+// comparison and equity functions take jokers in hands and decks with it, e.g.
+// WithEvaluator(WildEvaluator{}) together with WithDeck(NewDeck(WithJokers(1))).
+type WildEvaluator struct {
+	// Ranks are wild in every suit, in addition to jokers.
+	Ranks []Rank
+}
+
+func (e WildEvaluator) Evaluate(cards []Card) (HandRank, error) {
+	rank, _, err := EvaluateWild(cards, e.Ranks...)
+	return rank, err
+}
+
+// AcceptsJokers implements JokerAccepter.
+func (WildEvaluator) AcceptsJokers() bool {
+	return true
+}
+
+// wildHand holds the natural and the wild cards of a hand.
+// counts, suits and ranks describe the naturals, the latter two as bitmasks of ranks.
+type wildHand struct {
+	naturals []Card
+	wilds    []Card
+	counts   [RankAce + 1]int
+	suits    [Spades + 1]uint16
+	ranks    uint16
+}
+
+// wildPlay is the five ranks a hand plays, in the order of its HandRank.
+// suit is the suit of every card if suited is true.
+type wildPlay struct {
+	ranks  []Rank
+	suit   Suit
+	suited bool
+}
+
+// need returns the number of wild cards needed to hold n cards of rank r.
+func (w *wildHand) need(r Rank, n int) int {
+	return max(0, n-w.counts[r])
+}
+
+// best returns the strongest hand by checking each HandType from the strongest.
+func (w *wildHand) best() (HandRank, wildPlay) {
+	k := len(w.wilds)
+
+	for r := RankAce; r >= RankDeuce; r-- {
+		if w.need(r, 5) <= k {
+			return NewHandRank(HandTypeFiveOfAKind, r), wildPlay{ranks: []Rank{r, r, r, r, r}}
+		}
+	}
+
+	for top := RankAce; top >= RankFive; top-- {
+		for suit := Hearts; suit <= Spades; suit++ {
+			if straightMissing(w.suits[suit], top) <= k {
+				p := wildPlay{ranks: straightRanks(top), suit: suit, suited: true}
+				if top == RankAce {
+					return NewHandRank(HandTypeRoyalFlush, RankAce), p
+				}
+				return NewHandRank(HandTypeStraightFlush, top), p
+			}
+		}
+	}
+
+	for q := RankAce; q >= RankDeuce; q-- {
+		if n := w.need(q, 4); n <= k {
+			return w.withKickers(HandTypeFourOfAKind, []Rank{q, q, q, q}, k-n)
+		}
+	}
+
+	for t := RankAce; t >= RankDeuce; t-- {
+		nt := w.need(t, 3)
+		if nt > k {
+			continue
+		}
+		for p := RankAce; p >= RankDeuce; p-- {
+			if p != t && nt+w.need(p, 2) <= k {
+				return NewHandRank(HandTypeFullHouse, t, p), wildPlay{ranks: []Rank{t, t, t, p, p}}
+			}
+		}
+	}
+
+	var flush HandRank
+	var flushPlay wildPlay
+	for suit := Hearts; suit <= Spades; suit++ {
+		// Take the naturals of the suit from the top and fill the gaps with wild cards.
+		var ranks []Rank
+		left := k
+		for r := RankAce; r >= RankDeuce && len(ranks) < 5; r-- {
+			switch {
+			case w.suits[suit]&(1<<r) != 0:
+				ranks = append(ranks, r)
+			case left > 0:
+				ranks = append(ranks, r)
+				left--
+			}
+		}
+		if len(ranks) == 5 {
+			if rank := NewHandRank(HandTypeFlush, ranks...); rank > flush {
+				flush, flushPlay = rank, wildPlay{ranks: ranks, suit: suit, suited: true}
+			}
+		}
+	}
+	if flush != 0 {
+		return flush, flushPlay
+	}
+
+	for top := RankAce; top >= RankFive; top-- {
+		if straightMissing(w.ranks, top) <= k {
+			return NewHandRank(HandTypeStraight, top), wildPlay{ranks: straightRanks(top)}
+		}
+	}
+
+	for t := RankAce; t >= RankDeuce; t-- {
+		if n := w.need(t, 3); n <= k {
+			return w.withKickers(HandTypeThreeOfAKind, []Rank{t, t, t}, k-n)
+		}
+	}
+
+	for a := RankAce; a >= RankDeuce; a-- {
+		for b := a - 1; b >= RankDeuce; b-- {
+			if n := w.need(a, 2) + w.need(b, 2); n <= k {
+				return w.withKickers(HandTypeTwoPair, []Rank{a, a, b, b}, k-n)
+			}
+		}
+	}
+
+	for p := RankAce; p >= RankDeuce; p-- {
+		if n := w.need(p, 2); n <= k {
+			return w.withKickers(HandTypePair, []Rank{p, p}, k-n)
+		}
+	}
+
+	return w.withKickers(HandTypeHighCard, nil, k)
+}
+
+// withKickers completes made with the highest ranks that are not in it,
+// taking a natural card of a rank if there is one and a wild card otherwise.
+func (w *wildHand) withKickers(category HandType, made []Rank, wilds int) (HandRank, wildPlay) {
+	ranks := slices.Clone(made)
+	for r := RankAce; r >= RankDeuce && len(ranks) < 5; r-- {
+		switch {
+		case slices.Contains(made, r):
+		case w.counts[r] > 0:
+			ranks = append(ranks, r)
+		case wilds > 0:
+			ranks = append(ranks, r)
+			wilds--
+		}
+	}
+	return NewHandRank(category, slices.Compact(slices.Clone(ranks))...), wildPlay{ranks: ranks}
+}
+
+// straightRanks returns the ranks of the straight up to top from the highest, e.g. 5 4 3 2 A.
+func straightRanks(top Rank) []Rank {
+	ranks := make([]Rank, 0, 5)
+	for i := range Rank(5) {
+		r := top - i
+		if r < RankDeuce {
+			r = RankAce
+		}
+		ranks = append(ranks, r)
+	}
+	return ranks
+}
+
+// straightMissing returns the number of ranks of the straight up to top that mask lacks.
+func straightMissing(mask uint16, top Rank) int {
+	missing := 0
+	for _, r := range straightRanks(top) {
+		if mask&(1<<r) == 0 {
+			missing++
+		}
+	}
+	return missing
+}
+
+// cards returns the cards that play p: a natural card of each rank if there is one left,
+// and a wild card otherwise.
+func (w *wildHand) cards(p wildPlay) []Card {
+	var used CardSet
+	wilds := w.wilds
+	five := make([]Card, 0, len(p.ranks))
+	for _, r := range p.ranks {
+		i := slices.IndexFunc(w.naturals, func(c Card) bool {
+			return c.Rank == r && (!p.suited || c.Suit == p.suit) && !used.Contains(c)
+		})
+		if i < 0 {
+			five = append(five, wilds[0])
+			wilds = wilds[1:]
+			continue
+		}
+		used = used.Add(w.naturals[i])
+		five = append(five, w.naturals[i])
+	}
+	return five
+}
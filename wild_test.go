@@ -0,0 +1,293 @@
+package poker_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/whywaita/poker-go"
+)
+
+func TestEvaluateWild(t *testing.T) {
+	tests := []struct {
+		name      string
+		cards     string
+		wildRanks []poker.Rank
+		want      poker.HandRank
+		wantFive  string
+		wantDesc  string
+	}{
+		{
+			name:     "five of a kind",
+			cards:    "As Ah Ad Ac Xh 7d 2c",
+			want:     poker.NewHandRank(poker.HandTypeFiveOfAKind, poker.RankAce),
+			wantFive: "As Ah Ad Ac Xh",
+			wantDesc: "Five of a Kind, Aces",
+		},
+		{
+			name:      "five wild cards are five aces",
+			cards:     "2s 2h 2d 2c Xh",
+			want:      poker.NewHandRank(poker.HandTypeFiveOfAKind, poker.RankAce),
+			wildRanks: []poker.Rank{poker.RankDeuce},
+			wantFive:  "2s 2h 2d 2c Xh",
+		},
+		{
+			name:     "joker completes a royal flush",
+			cards:    "Ah Kh Qh Th Xs 9c 9d",
+			want:     poker.NewHandRank(poker.HandTypeRoyalFlush, poker.RankAce),
+			wantFive: "Ah Kh Qh Xs Th",
+		},
+		{
+			name:      "deuces wild straight flush beats quads",
+			cards:     "9s 8s 6s 5s 2h 9c 9d",
+			wildRanks: []poker.Rank{poker.RankDeuce},
+			want:      poker.NewHandRank(poker.HandTypeStraightFlush, poker.RankNine),
+			wantFive:  "9s 8s 2h 6s 5s",
+		},
+		{
+			name:     "wild card in a flush plays the highest missing rank",
+			cards:    "Ah Jh 8h 4h Xs 4c 7d",
+			want:     poker.NewHandRank(poker.HandTypeFlush, poker.RankAce, poker.RankKing, poker.RankJack, poker.RankEight, poker.RankFour),
+			wantFive: "Ah Xs Jh 8h 4h",
+		},
+		{
+			name:     "wild card pairs the highest card",
+			cards:    "Kd 9c 7h 4s Xh",
+			want:     poker.NewHandRank(poker.HandTypePair, poker.RankKing, poker.RankNine, poker.RankSeven, poker.RankFour),
+			wantFive: "Kd Xh 9c 7h 4s",
+		},
+		{
+			name:     "joker makes a wheel",
+			cards:    "Ac 2d 3h 4s Xh Kd",
+			want:     poker.NewHandRank(poker.HandTypeStraight, poker.RankFive),
+			wantFive: "Xh 4s 3h 2d Ac",
+		},
+		{
+			name:     "no wild cards",
+			cards:    "As Kd 9c 7h 4s",
+			want:     poker.NewHandRank(poker.HandTypeHighCard, poker.RankAce, poker.RankKing, poker.RankNine, poker.RankSeven, poker.RankFour),
+			wantFive: "As Kd 9c 7h 4s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, five, err := poker.EvaluateWild(poker.MustParseCards(tt.cards), tt.wildRanks...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rank != tt.want {
+				t.Errorf("rank = %s, want %s", rank, tt.want)
+			}
+			if diff := cmp.Diff(poker.MustParseCards(tt.wantFive), five); diff != "" {
+				t.Errorf("five cards mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantDesc != "" && rank.Describe() != tt.wantDesc {
+				t.Errorf("Describe() = %q, want %q", rank.Describe(), tt.wantDesc)
+			}
+		})
+	}
+}
+
+func TestEvaluateWild_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []poker.Card
+	}{
+		{name: "four cards", cards: poker.MustParseCards("Xh As Kd 9c")},
+		{name: "unknown card", cards: []poker.Card{poker.RedJoker, {Rank: poker.RankUnknown}, {Rank: poker.RankAce}, {Rank: poker.RankKing}, {Rank: poker.RankQueen}}},
+		{name: "duplicate joker", cards: []poker.Card{poker.RedJoker, poker.RedJoker, {Rank: poker.RankAce}, {Rank: poker.RankKing}, {Rank: poker.RankQueen}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := poker.EvaluateWild(tt.cards); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestEvaluateWild_MatchesEvaluate(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	deck := poker.NewDeck()
+	for range 1000 {
+		cards := slices.Clone(deck.Cards)
+		rng.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+		cards = cards[:5+rng.IntN(3)]
+
+		want, _, err := poker.EvaluateRank(cards)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got, _, err := poker.EvaluateWild(cards)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != want {
+			t.Errorf("EvaluateWild(%s) = %s, want %s", poker.Cards(cards), got, want)
+		}
+	}
+}
+
+func TestEvaluateWild_Random(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	deck := poker.NewDeck(poker.WithJokers(2))
+	isWild := func(c poker.Card) bool {
+		return c.Rank == poker.RankJoker || c.Rank == poker.RankDeuce
+	}
+	for range 500 {
+		cards := slices.Clone(deck.Cards)
+		rng.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+		// Keep the brute force small: five cards with up to two wild cards,
+		// or seven cards with one.
+		cards = cards[:5+2*rng.IntN(2)]
+		wilds := 0
+		for _, c := range cards {
+			if isWild(c) {
+				wilds++
+			}
+		}
+		if wilds > 2 || (len(cards) == 7 && wilds > 1) {
+			continue
+		}
+
+		got, five, err := poker.EvaluateWild(cards, poker.RankDeuce)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if want := wildReference(cards, isWild); got != want {
+			t.Errorf("EvaluateWild(%s) = %s, want %s", poker.Cards(cards), got, want)
+		}
+		if !poker.NewCardSet(cards...).ContainsAll(poker.NewCardSet(five...)) || len(five) != 5 {
+			t.Errorf("EvaluateWild(%s) five cards %s are not from the hand", poker.Cards(cards), poker.Cards(five))
+		}
+	}
+}
+
+// wildReference returns the best hand of cards by trying every card for each wild card.
+func wildReference(cards []poker.Card, isWild func(poker.Card) bool) poker.HandRank {
+	var naturals []poker.Card
+	for _, c := range poker.NewDeck().Cards {
+		naturals = append(naturals, c)
+	}
+
+	var best poker.HandRank
+	for _, combo := range poker.AllCombinations(cards, 5) {
+		var try func(i int, five []poker.Card)
+		try = func(i int, five []poker.Card) {
+			if i == len(five) {
+				best = max(best, fiveCardReference(five))
+				return
+			}
+			if !isWild(combo[i]) {
+				try(i+1, five)
+				return
+			}
+			for _, c := range naturals {
+				five[i] = c
+				try(i+1, five)
+			}
+		}
+		try(0, slices.Clone(combo))
+	}
+	return best
+}
+
+// fiveCardReference ranks five natural cards that may repeat.
+// A flush needs five distinct ranks.
+func fiveCardReference(five []poker.Card) poker.HandRank {
+	counts := make(map[poker.Rank]int)
+	suits := make(map[poker.Suit]bool)
+	for _, c := range five {
+		counts[c.Rank]++
+		suits[c.Suit] = true
+	}
+	var ranks []poker.Rank
+	for r := range counts {
+		ranks = append(ranks, r)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if counts[ranks[i]] != counts[ranks[j]] {
+			return counts[ranks[i]] > counts[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+
+	distinct := len(ranks) == 5
+	straight := distinct && ranks[0]-ranks[4] == 4
+	if distinct && ranks[0] == poker.RankAce && ranks[1] == poker.RankFive {
+		straight = true
+		ranks = []poker.Rank{poker.RankFive}
+	}
+	flush := distinct && len(suits) == 1
+	switch {
+	case counts[ranks[0]] == 5:
+		return poker.NewHandRank(poker.HandTypeFiveOfAKind, ranks...)
+	case straight && flush && ranks[0] == poker.RankAce:
+		return poker.NewHandRank(poker.HandTypeRoyalFlush, poker.RankAce)
+	case straight && flush:
+		return poker.NewHandRank(poker.HandTypeStraightFlush, ranks[0])
+	case counts[ranks[0]] == 4:
+		return poker.NewHandRank(poker.HandTypeFourOfAKind, ranks...)
+	case counts[ranks[0]] == 3 && counts[ranks[1]] == 2:
+		return poker.NewHandRank(poker.HandTypeFullHouse, ranks...)
+	case flush:
+		return poker.NewHandRank(poker.HandTypeFlush, ranks...)
+	case straight:
+		return poker.NewHandRank(poker.HandTypeStraight, ranks[0])
+	case counts[ranks[0]] == 3:
+		return poker.NewHandRank(poker.HandTypeThreeOfAKind, ranks...)
+	case counts[ranks[0]] == 2 && counts[ranks[1]] == 2:
+		return poker.NewHandRank(poker.HandTypeTwoPair, ranks...)
+	case counts[ranks[0]] == 2:
+		return poker.NewHandRank(poker.HandTypePair, ranks...)
+	default:
+		return poker.NewHandRank(poker.HandTypeHighCard, ranks...)
+	}
+}
+
+func TestWildEvaluator(t *testing.T) {
+	players := []poker.Player{
+		{Name: "joker", Hand: poker.MustParseCards("Xh Kd")},
+		{Name: "aces", Hand: poker.MustParseCards("As Ac")},
+	}
+	board := poker.MustParseCards("Ah Kc Ks 7d 3c")
+
+	// Without wild cards, jokers are rejected.
+	if _, err := poker.CompareHands(players, board); err == nil {
+		t.Error("expected error for a joker without WildEvaluator")
+	}
+
+	// Four kings beat aces full of kings.
+	winners, err := poker.CompareHands(players, board, poker.WithEvaluator(poker.WildEvaluator{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"joker"}, playerNames(winners)); diff != "" {
+		t.Errorf("winners mismatch (-want +got):\n%s", diff)
+	}
+	winners, err = poker.CompareHands(players, board, poker.WithEvaluator(&poker.WildEvaluator{}))
+	if err != nil {
+		t.Fatalf("unexpected error with *WildEvaluator: %s", err)
+	}
+	if diff := cmp.Diff([]string{"joker"}, playerNames(winners)); diff != "" {
+		t.Errorf("*WildEvaluator winners mismatch (-want +got):\n%s", diff)
+	}
+
+	equities, err := poker.EvaluateEquityByMadeHandWithCommunity(players, board[:4],
+		poker.WithDeck(poker.NewDeck(poker.WithJokers(2))),
+		poker.WithEvaluator(poker.WildEvaluator{}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Of the 46 river cards, only the last ace saves the aces: four aces beat four kings.
+	// The black joker gives both wild cards to the other player, making five kings.
+	want := []float64{45.0 / 46, 1.0 / 46}
+	if diff := cmp.Diff(want, equities, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("equity mismatch (-want +got):\n%s", diff)
+	}
+}